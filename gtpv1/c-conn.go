@@ -0,0 +1,769 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+)
+
+// CPlaneConn represents a C-Plane Connection of GTPv1.
+//
+// CPlaneConn provides the automatic handling of message by adding handlers to it with
+// AddHandler(s). See AddHandler for detailed usage.
+//
+// CPlaneConn also provides the functions to manage PDPContexts that works over the
+// connection(=between a node to another).
+// See the docs of CreatePDPContext, RegisterPDPContext, DeletePDPContext methods for details.
+type CPlaneConn struct {
+	mu      sync.Mutex
+	laddr   net.Addr
+	pktConn net.PacketConn
+	*iteiPDPContextMap
+	*imsiPDPContextMap
+
+	validationEnabled bool
+
+	closeCh chan struct{}
+	*msgHandlerMap
+
+	// sequence is the last SequenceNumber used in the request.
+	sequence uint16
+
+	// RestartCounter is the RestartCounter value in Recovery IE, which represents how many
+	// times the GTPv1-C endpoint is restarted.
+	RestartCounter uint8
+
+	paths *pathManager
+}
+
+// NewCPlaneConn creates a new CPlaneConn used for server. On client side, use DialCPlane instead.
+func NewCPlaneConn(laddr net.Addr, counter uint8) *CPlaneConn {
+	c := &CPlaneConn{
+		mu:                sync.Mutex{},
+		laddr:             laddr,
+		iteiPDPContextMap: newiteiPDPContextMap(),
+		imsiPDPContextMap: newimsiPDPContextMap(),
+		validationEnabled: true,
+		closeCh:           make(chan struct{}),
+		msgHandlerMap:     newDefaultCPlaneMsgHandlerMap(),
+		sequence:          0,
+		RestartCounter:    counter,
+	}
+	c.paths = newPathManager(c, func(raddr net.Addr) error {
+		_, err := c.EchoRequest(raddr)
+		return err
+	}, c.closeCh)
+
+	return c
+}
+
+// DialCPlane sends Echo Request to raddr to check if the endpoint is alive and returns CPlaneConn.
+//
+// It does not bind the raddr to the underlying connection, which enables a CPlaneConn to
+// send to/receive from multiple peers with single laddr.
+//
+// If Echo exchange is unnecessary, use NewCPlaneConn and ListenAndServe instead.
+func DialCPlane(ctx context.Context, laddr, raddr net.Addr, counter uint8) (*CPlaneConn, error) {
+	c := NewCPlaneConn(laddr, counter)
+
+	// setup underlying connection first.
+	// not using net.Dial, as it binds src/dst IP:Port, which makes it harder to
+	// handle multiple connections with a CPlaneConn.
+	var err error
+	c.pktConn, err = net.ListenPacket(c.laddr.Network(), c.laddr.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// send EchoRequest to raddr.
+	if _, err := c.EchoRequest(raddr); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 1500)
+
+	// if no response coming within 3 seconds, returns error without retrying.
+	if err := c.pktConn.SetReadDeadline(time.Now().Add(3 * time.Second)); err != nil {
+		return nil, err
+	}
+	n, raddr, err := c.pktConn.ReadFrom(buf)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.pktConn.SetReadDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+
+	// decode incoming message and let it be handled by default handler funcs.
+	msg, err := message.Parse(buf[:n])
+	if err != nil {
+		return nil, err
+	}
+	if err := c.handleMessage(raddr, msg); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := c.Serve(ctx); err != nil {
+			logf("fatal error on CPlaneConn %s: %s", c.LocalAddr(), err)
+		}
+	}()
+	return c, nil
+}
+
+// ListenAndServe creates a new GTPv1-C CPlaneConn and start serving.
+// This blocks, and returns error only if it face the fatal one. Non-fatal errors are logged
+// with logger. See SetLogger/EnableLogger/DisableLogger for handling of those logs.
+func (c *CPlaneConn) ListenAndServe(ctx context.Context) error {
+	if err := c.Listen(ctx); err != nil {
+		return err
+	}
+	return c.Serve(ctx)
+}
+
+// Listen creates a new GTPv1-C CPlaneConn without serving.
+// Call Serve to start handling the incoming messages.
+func (c *CPlaneConn) Listen(ctx context.Context) error {
+	var err error
+	c.mu.Lock()
+	c.pktConn, err = net.ListenPacket(c.laddr.Network(), c.laddr.String())
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *CPlaneConn) closed() <-chan struct{} {
+	return c.closeCh
+}
+
+// Serve starts serving GTPv1-C connection.
+func (c *CPlaneConn) Serve(ctx context.Context) error {
+	go func() {
+		select { // ctx is canceled or Close() is called
+		case <-ctx.Done():
+		case <-c.closed():
+		}
+		// the path management should not keep sending Echo Request on the closed conn.
+		c.paths.stop()
+
+		if err := c.pktConn.Close(); err != nil {
+			logf("error closing the underlying conn: %s", err)
+		}
+	}()
+
+	buf := make([]byte, 1500)
+	for {
+		n, raddr, err := c.pktConn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return fmt.Errorf("error reading from CPlaneConn %s: %w", c.LocalAddr(), err)
+		}
+
+		raw := make([]byte, n)
+		copy(raw, buf)
+		go func() {
+			msg, err := message.Parse(raw)
+			if err != nil {
+				logf("error parsing the message: %v, %x", err, raw)
+				return
+			}
+
+			if err := c.handleMessage(raddr, msg); err != nil {
+				logf("error handling message on CPlaneConn %s: %v", c.LocalAddr(), err)
+			}
+		}()
+	}
+}
+
+// ReadFrom reads a packet from the connection,
+// copying the payload into p. It returns the number of
+// bytes copied into p and the return address that
+// was on the packet.
+// It returns the number of bytes read (0 <= n <= len(p))
+// and any error encountered. Callers should always process
+// the n > 0 bytes returned before considering the error err.
+// ReadFrom can be made to time out and return
+// an Error with Timeout() == true after a fixed time limit;
+// see SetDeadline and SetReadDeadline.
+func (c *CPlaneConn) ReadFrom(p []byte) (n int, addr net.Addr, err error) {
+	return c.pktConn.ReadFrom(p)
+}
+
+// WriteTo writes a packet with payload p to addr.
+// WriteTo can be made to time out and return
+// an Error with Timeout() == true after a fixed time limit;
+// see SetDeadline and SetWriteDeadline.
+// On packet-oriented connections, write timeouts are rare.
+func (c *CPlaneConn) WriteTo(p []byte, addr net.Addr) (n int, err error) {
+	return c.pktConn.WriteTo(p, addr)
+}
+
+// Close closes the connection.
+// Any blocked Read or Write operations will be unblocked and return errors.
+func (c *CPlaneConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	close(c.closeCh)
+
+	return nil
+}
+
+// LocalAddr returns the local network address.
+func (c *CPlaneConn) LocalAddr() net.Addr {
+	return c.pktConn.LocalAddr()
+}
+
+// SetDeadline sets the read and write deadlines associated
+// with the connection. It is equivalent to calling both
+// SetReadDeadline and SetWriteDeadline.
+//
+// A deadline is an absolute time after which I/O operations
+// fail with a timeout (see type Error) instead of
+// blocking. The deadline applies to all future and pending
+// I/O, not just the immediately following call to Read or
+// Write. After a deadline has been exceeded, the connection
+// can be refreshed by setting a deadline in the future.
+//
+// An idle timeout can be implemented by repeatedly extending
+// the deadline after successful Read or Write calls.
+//
+// A zero value for t means I/O operations will not time out.
+func (c *CPlaneConn) SetDeadline(t time.Time) error {
+	return c.pktConn.SetDeadline(t)
+}
+
+// SetReadDeadline sets the deadline for future Read calls
+// and any currently-blocked Read call.
+// A zero value for t means Read will not time out.
+func (c *CPlaneConn) SetReadDeadline(t time.Time) error {
+	return c.pktConn.SetReadDeadline(t)
+}
+
+// SetWriteDeadline sets the deadline for future Write calls
+// and any currently-blocked Write call.
+// Even if write times out, it may return n > 0, indicating that
+// some of the data was successfully written.
+// A zero value for t means Write will not time out.
+func (c *CPlaneConn) SetWriteDeadline(t time.Time) error {
+	return c.pktConn.SetWriteDeadline(t)
+}
+
+// AddHandler adds a message handler to *CPlaneConn.
+//
+// By adding HandlerFuncs, *CPlaneConn will handle the specified type of message with
+// it's paired HandlerFunc when receiving. Messages without registered handlers are just
+// ignored and logged.
+//
+// This should be performed just after creating *CPlaneConn, otherwise the user cannot retrieve
+// any values, which is in most cases vital to continue working as a node, from the incoming
+// message.
+//
+// The Conn given to the HandlerFunc can be asserted to *CPlaneConn to access the PDPContexts.
+//
+// HandlerFuncs for EchoRequest and EchoResponse are registered by default.
+// These HandlerFuncs can be overwritten by specifying message.MsgTypeEchoRequest and/or
+// message.MsgTypeEchoResponse as msgType parameter.
+func (c *CPlaneConn) AddHandler(msgType uint8, fn HandlerFunc) {
+	c.msgHandlerMap.store(msgType, fn)
+}
+
+// AddHandlers adds multiple handler funcs at a time.
+//
+// See AddHandler for detailed usage.
+func (c *CPlaneConn) AddHandlers(funcs map[uint8]HandlerFunc) {
+	for msgType, fn := range funcs {
+		c.msgHandlerMap.store(msgType, fn)
+	}
+}
+
+func (c *CPlaneConn) handleMessage(senderAddr net.Addr, msg message.Message) error {
+	if c.validationEnabled {
+		if err := c.validate(senderAddr, msg); err != nil {
+			return fmt.Errorf("failed to validate %s: %w", msg.MessageTypeName(), err)
+		}
+	}
+	c.paths.observe(senderAddr, msg)
+
+	handle, ok := c.msgHandlerMap.load(msg.MessageType())
+	if !ok {
+		return &HandlerNotFoundError{MsgType: msg.MessageTypeName()}
+	}
+
+	if err := handle(c, senderAddr, msg); err != nil {
+		return fmt.Errorf("failed to handle %s: %w", msg.MessageTypeName(), err)
+	}
+
+	return nil
+}
+
+// EnableValidation turns on automatic validation of incoming message.
+// This is expected to be used only after DisableValidation() is used, as the validation
+// is enabled by default.
+//
+// CPlaneConn checks if TEID is known to CPlaneConn.
+//
+// Even the validation is failed, it does not return error to user. Instead, it just logs
+// and discards the packets so that the HandlerFunc won't get the invalid message.
+// Extra validations should be done in HandlerFunc.
+func (c *CPlaneConn) EnableValidation() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.validationEnabled = true
+}
+
+// DisableValidation turns off automatic validation of incoming message.
+// It is not recommended to use this except the node is in debugging mode.
+//
+// See EnableValidation for what are validated.
+func (c *CPlaneConn) DisableValidation() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.validationEnabled = false
+}
+
+func (c *CPlaneConn) validate(senderAddr net.Addr, msg message.Message) error {
+	// check if TEID is known or not
+	if teid := msg.TEID(); teid != 0 {
+		if _, err := c.GetPDPContextByTEID(teid, senderAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SendMessageTo sends a message to addr.
+// Unlike WriteTo, it sets the Sequence Number properly and returns the one used in the message.
+func (c *CPlaneConn) SendMessageTo(msg message.Message, addr net.Addr) (uint16, error) {
+	seq := c.IncSequence()
+	msg.SetSequenceNumber(seq)
+
+	payload, err := message.Marshal(msg)
+	if err != nil {
+		seq = c.DecSequence()
+		return seq, fmt.Errorf("failed to send %T: %w", msg, err)
+	}
+
+	if _, err := c.WriteTo(payload, addr); err != nil {
+		seq = c.DecSequence()
+		return seq, fmt.Errorf("failed to send %T: %w", msg, err)
+	}
+	return seq, nil
+}
+
+// IncSequence increments the SequenceNumber associated with CPlaneConn.
+//
+// SequenceNumber is 2-octet long in GTPv1 and wraps around to 0 after 0xffff.
+func (c *CPlaneConn) IncSequence() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sequence++
+
+	return c.sequence
+}
+
+// DecSequence decrements the SequenceNumber associated with CPlaneConn.
+func (c *CPlaneConn) DecSequence() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sequence--
+
+	return c.sequence
+}
+
+// SequenceNumber returns the current(=last used) SequenceNumber associated with CPlaneConn.
+func (c *CPlaneConn) SequenceNumber() uint16 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.sequence
+}
+
+// EchoRequest sends a EchoRequest.
+func (c *CPlaneConn) EchoRequest(raddr net.Addr) (uint16, error) {
+	msg := message.NewEchoRequest(0)
+
+	seq, err := c.SendMessageTo(msg, raddr)
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
+// EchoResponse sends a EchoResponse in response to the EchoRequest.
+func (c *CPlaneConn) EchoResponse(raddr net.Addr, req message.Message) error {
+	res := message.NewEchoResponse(0, ie.NewRecovery(c.RestartCounter))
+
+	if err := c.RespondTo(raddr, req, res); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RespondTo sends a message(specified with "toBeSent" param) in response to a message
+// (specified with "received" param).
+//
+// This exists to make it easier to handle SequenceNumber.
+func (c *CPlaneConn) RespondTo(raddr net.Addr, received, toBeSent message.Message) error {
+	toBeSent.SetSequenceNumber(received.Sequence())
+	b := make([]byte, toBeSent.MarshalLen())
+
+	if err := toBeSent.MarshalTo(b); err != nil {
+		return err
+	}
+
+	if _, err := c.WriteTo(b, raddr); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Restarts returns the number of restarts in uint8.
+func (c *CPlaneConn) Restarts() uint8 {
+	return c.RestartCounter
+}
+
+// ParseCreatePDPContextRequest creates a PDPContext from the Create PDP Context Request
+// received from raddr.
+//
+// The TEIDs in the request are the ones allocated by the sender, and thus set as the
+// outgoing TEIDs of the PDPContext. The PDPContext returned is not registered to
+// CPlaneConn; allocate the incoming TEID-C with NewTEIDCPlane and call RegisterPDPContext.
+func (c *CPlaneConn) ParseCreatePDPContextRequest(raddr net.Addr, req *message.CreatePDPContextRequest) (*PDPContext, error) {
+	pdp, err := newPDPContextFromRequest(raddr, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if i := req.TEIDCPlane; i != nil {
+		teid, err := i.TEID()
+		if err != nil {
+			return nil, err
+		}
+		pdp.SetOutgoingTEIDC(teid)
+	}
+	if i := req.TEIDDataI; i != nil {
+		teid, err := i.TEID()
+		if err != nil {
+			return nil, err
+		}
+		pdp.SetOutgoingTEIDU(teid)
+	}
+	if i := req.SGSNAddressForUserTraffic; i != nil {
+		ip, err := i.IP()
+		if err != nil {
+			return nil, err
+		}
+		pdp.SetRemoteUPlaneAddress(&net.UDPAddr{IP: ip, Port: 2152})
+	}
+
+	return pdp, nil
+}
+
+func newPDPContextFromRequest(raddr net.Addr, req *message.CreatePDPContextRequest) (*PDPContext, error) {
+	if req.IMSI == nil {
+		return nil, &RequiredIEMissingError{Type: ie.IMSI}
+	}
+	imsi, err := req.IMSI.IMSI()
+	if err != nil {
+		return nil, err
+	}
+
+	if req.NSAPI == nil {
+		return nil, &RequiredIEMissingError{Type: ie.NSAPI}
+	}
+	nsapi, err := req.NSAPI.NSAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	pdp := NewPDPContext(raddr, imsi, nsapi)
+	if i := req.MSISDN; i != nil {
+		pdp.MSISDN, err = i.MSISDN()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if i := req.IMEI; i != nil {
+		pdp.IMEI, err = i.IMEISV()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if i := req.APN; i != nil {
+		pdp.APN, err = i.AccessPointName()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if i := req.RATType; i != nil {
+		pdp.RATType, err = i.RATType()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if i := req.EndUserAddress; i != nil {
+		// the address is empty when it is to be allocated dynamically.
+		if ip, err := i.IP(); err == nil {
+			pdp.MSAddress = ip.String()
+		}
+	}
+
+	return pdp, nil
+}
+
+// CreatePDPContext sends a CreatePDPContextRequest and returns the PDPContext created
+// with the values in IEs given, with the Sequence Number used in the request.
+//
+// The TEID C-Plane and TEID Data I given are set as the incoming TEIDs of the PDPContext,
+// and the PDPContext is registered to CPlaneConn with the TEID-C so that the response
+// can be handled with GetPDPContextByTEID. Use NewTEIDCPlane to create the TEID C-Plane IE.
+//
+// Note that this method doesn't care IEs given are sufficient or not, as the required IE
+// varies much depending on the context in which the Create PDP Context Request is used.
+func (c *CPlaneConn) CreatePDPContext(raddr net.Addr, ies ...*ie.IE) (*PDPContext, uint16, error) {
+	msg := message.NewCreatePDPContextRequest(0, 0, ies...)
+
+	pdp, err := newPDPContextFromRequest(raddr, msg)
+	if err != nil {
+		return nil, 0, err
+	}
+	if i := msg.TEIDDataI; i != nil {
+		teid, err := i.TEID()
+		if err != nil {
+			return nil, 0, err
+		}
+		pdp.SetIncomingTEIDU(teid)
+	}
+	if i := msg.TEIDCPlane; i != nil {
+		teid, err := i.TEID()
+		if err != nil {
+			return nil, 0, err
+		}
+		c.RegisterPDPContext(teid, pdp)
+	}
+
+	seq, err := c.SendMessageTo(msg, raddr)
+	if err != nil {
+		return nil, 0, err
+	}
+	return pdp, seq, nil
+}
+
+// UpdatePDPContext sends an UpdatePDPContextRequest with IEs given to the peer of
+// the PDPContext, using its outgoing TEID-C.
+func (c *CPlaneConn) UpdatePDPContext(pdp *PDPContext, ies ...*ie.IE) (uint16, error) {
+	msg := message.NewUpdatePDPContextRequest(pdp.OutgoingTEIDC(), 0, ies...)
+
+	seq, err := c.SendMessageTo(msg, pdp.PeerAddr())
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
+// DeletePDPContext sends a DeletePDPContextRequest with IEs given to the peer of
+// the PDPContext, using its outgoing TEID-C.
+//
+// The PDPContext is not removed from CPlaneConn by this method. Call RemovePDPContext
+// when the response comes.
+func (c *CPlaneConn) DeletePDPContext(pdp *PDPContext, ies ...*ie.IE) (uint16, error) {
+	msg := message.NewDeletePDPContextRequest(pdp.OutgoingTEIDC(), 0, ies...)
+
+	seq, err := c.SendMessageTo(msg, pdp.PeerAddr())
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
+// GetPDPContextByTEID returns PDPContext looked up by incoming TEID-C and sender of the message.
+func (c *CPlaneConn) GetPDPContextByTEID(teid uint32, peer net.Addr) (*PDPContext, error) {
+	pdp, ok := c.iteiPDPContextMap.load(teid)
+	if !ok {
+		return nil, &InvalidTEIDError{TEID: teid}
+	}
+	if peer.String() != pdp.peerAddrString {
+		return nil, &InvalidTEIDError{TEID: teid}
+	}
+	return pdp, nil
+}
+
+// GetPDPContextByNSAPI returns PDPContext looked up by IMSI and NSAPI.
+func (c *CPlaneConn) GetPDPContextByNSAPI(imsi string, nsapi uint8) (*PDPContext, error) {
+	if pdp, ok := c.imsiPDPContextMap.load(imsi, nsapi); ok {
+		return pdp, nil
+	}
+	return nil, &PDPContextNotFoundError{IMSI: imsi, NSAPI: nsapi}
+}
+
+// GetPDPContextsByIMSI returns all the PDPContexts of the subscriber looked up by IMSI.
+func (c *CPlaneConn) GetPDPContextsByIMSI(imsi string) []*PDPContext {
+	var ps []*PDPContext
+	c.imsiPDPContextMap.rangeWithFunc(func(k, v interface{}) bool {
+		if k.(imsiNSAPI).imsi == imsi {
+			ps = append(ps, v.(*PDPContext))
+		}
+		return true
+	})
+
+	return ps
+}
+
+// RegisterPDPContext registers PDPContext to CPlaneConn with its incoming TEID-C to
+// distinguish which PDPContext the incoming message are for.
+func (c *CPlaneConn) RegisterPDPContext(itei uint32, pdp *PDPContext) {
+	pdp.SetIncomingTEIDC(itei)
+
+	c.iteiPDPContextMap.store(itei, pdp)
+	c.imsiPDPContextMap.store(pdp.IMSI, pdp.NSAPI, pdp)
+}
+
+// RemovePDPContext removes a PDPContext registered in CPlaneConn.
+func (c *CPlaneConn) RemovePDPContext(pdp *PDPContext) {
+	c.imsiPDPContextMap.delete(pdp.IMSI, pdp.NSAPI)
+	c.iteiPDPContextMap.delete(pdp.IncomingTEIDC())
+}
+
+// NewTEIDCPlane creates a new TEID C-Plane IE with random TEID value that is unique
+// within CPlaneConn. To ensure the uniqueness, don't create in the other way if you
+// once use this method.
+//
+// Note that in the case there's a lot of PDPContext on the CPlaneConn, it may take a
+// long time to find a new unique value.
+func (c *CPlaneConn) NewTEIDCPlane() *ie.IE {
+	var teid uint32
+	for try := uint32(0); try < 0xffff; try++ {
+		const logEvery = 0xff
+		if try&logEvery == logEvery {
+			logf("Generating NewTEIDCPlane crossed tries:%d", try)
+		}
+
+		t := generateRandomUint32()
+		if t == 0 {
+			continue
+		}
+
+		// Try to mark TEID as taken. Fails if something exists
+		if ok := c.iteiPDPContextMap.tryStore(t, nil); !ok {
+			continue
+		}
+
+		teid = t
+		break
+	}
+
+	if teid == 0 {
+		return nil
+	}
+	return ie.NewTEIDCPlane(teid)
+}
+
+// PDPContexts returns all the PDPContexts registered in CPlaneConn.
+func (c *CPlaneConn) PDPContexts() []*PDPContext {
+	var ps []*PDPContext
+	c.imsiPDPContextMap.rangeWithFunc(func(k, v interface{}) bool {
+		ps = append(ps, v.(*PDPContext))
+		return true
+	})
+
+	return ps
+}
+
+// PDPContextCount returns the number of active PDPContexts registered in CPlaneConn.
+//
+// This may have some impact on performance in case of large number of PDPContext exists.
+func (c *CPlaneConn) PDPContextCount() int {
+	var count int
+	c.imsiPDPContextMap.rangeWithFunc(func(k, v interface{}) bool {
+		if v.(*PDPContext).IsActive() {
+			count++
+		}
+		return true
+	})
+
+	return count
+}
+
+type imsiNSAPI struct {
+	imsi  string
+	nsapi uint8
+}
+
+type imsiPDPContextMap struct {
+	syncMap sync.Map
+}
+
+func newimsiPDPContextMap() *imsiPDPContextMap {
+	return &imsiPDPContextMap{}
+}
+
+func (i *imsiPDPContextMap) store(imsi string, nsapi uint8, pdp *PDPContext) {
+	i.syncMap.Store(imsiNSAPI{imsi, nsapi}, pdp)
+}
+
+func (i *imsiPDPContextMap) load(imsi string, nsapi uint8) (*PDPContext, bool) {
+	pdp, ok := i.syncMap.Load(imsiNSAPI{imsi, nsapi})
+	if ok && pdp != nil {
+		return pdp.(*PDPContext), true
+	}
+	return nil, false
+}
+
+func (i *imsiPDPContextMap) delete(imsi string, nsapi uint8) {
+	i.syncMap.Delete(imsiNSAPI{imsi, nsapi})
+}
+
+func (i *imsiPDPContextMap) rangeWithFunc(fn func(key, pdp interface{}) bool) {
+	i.syncMap.Range(fn)
+}
+
+type iteiPDPContextMap struct {
+	syncMap sync.Map
+}
+
+func newiteiPDPContextMap() *iteiPDPContextMap {
+	return &iteiPDPContextMap{}
+}
+
+func (t *iteiPDPContextMap) store(teid uint32, pdp *PDPContext) {
+	t.syncMap.Store(teid, pdp)
+}
+
+func (t *iteiPDPContextMap) tryStore(teid uint32, pdp *PDPContext) bool {
+	_, loaded := t.syncMap.LoadOrStore(teid, pdp)
+	return !loaded
+}
+
+func (t *iteiPDPContextMap) load(teid uint32) (*PDPContext, bool) {
+	v, ok := t.syncMap.Load(teid)
+	if !ok {
+		return nil, false
+	}
+
+	// the TEID reserved by NewTEIDCPlane has no PDPContext yet.
+	pdp, _ := v.(*PDPContext)
+	return pdp, pdp != nil
+}
+
+func (t *iteiPDPContextMap) delete(teid uint32) {
+	t.syncMap.Delete(teid)
+}
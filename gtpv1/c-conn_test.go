@@ -0,0 +1,338 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv1_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+)
+
+const (
+	testIMSI  = "123451234567890"
+	testNSAPI = 5
+)
+
+func setupCPlane(ctx context.Context, t *testing.T) (cliConn, srvConn *gtpv1.CPlaneConn) {
+	t.Helper()
+
+	laddr, err := net.ResolveUDPAddr("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srvConn = gtpv1.NewCPlaneConn(laddr, 0)
+	if err := srvConn.Listen(ctx); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := srvConn.Serve(ctx); err != nil {
+			t.Errorf("error on serving: %v", err)
+		}
+	}()
+
+	cliConn, err = gtpv1.DialCPlane(ctx, laddr, srvConn.LocalAddr(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cliConn, srvConn
+}
+
+func TestPDPContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cliConn, srvConn := setupCPlane(ctx, t)
+
+	// GGSN side.
+	srvConn.AddHandlers(map[uint8]gtpv1.HandlerFunc{
+		message.MsgTypeCreatePDPContextRequest: func(c gtpv1.Conn, senderAddr net.Addr, msg message.Message) error {
+			req := msg.(*message.CreatePDPContextRequest)
+			cc := c.(*gtpv1.CPlaneConn)
+
+			pdp, err := cc.ParseCreatePDPContextRequest(senderAddr, req)
+			if err != nil {
+				return err
+			}
+			teidC := cc.NewTEIDCPlane()
+			cc.RegisterPDPContext(teidC.MustTEID(), pdp)
+			if err := pdp.Activate(); err != nil {
+				return err
+			}
+
+			return c.RespondTo(senderAddr, msg, message.NewCreatePDPContextResponse(
+				pdp.OutgoingTEIDC(), 0,
+				ie.NewCause(gtpv1.ResCauseRequestAccepted),
+				ie.NewTEIDDataI(0x22222222),
+				teidC,
+				ie.NewNSAPI(pdp.NSAPI),
+			))
+		},
+		message.MsgTypeDeletePDPContextRequest: func(c gtpv1.Conn, senderAddr net.Addr, msg message.Message) error {
+			cc := c.(*gtpv1.CPlaneConn)
+
+			pdp, err := cc.GetPDPContextByTEID(msg.TEID(), senderAddr)
+			if err != nil {
+				return err
+			}
+			cc.RemovePDPContext(pdp)
+
+			return c.RespondTo(senderAddr, msg, message.NewDeletePDPContextResponse(
+				pdp.OutgoingTEIDC(), 0, ie.NewCause(gtpv1.ResCauseRequestAccepted),
+			))
+		},
+	})
+
+	// SGSN side.
+	doneCh := make(chan *gtpv1.PDPContext)
+	cliConn.AddHandlers(map[uint8]gtpv1.HandlerFunc{
+		message.MsgTypeCreatePDPContextResponse: func(c gtpv1.Conn, senderAddr net.Addr, msg message.Message) error {
+			res := msg.(*message.CreatePDPContextResponse)
+
+			pdp, err := c.(*gtpv1.CPlaneConn).GetPDPContextByTEID(res.TEID(), senderAddr)
+			if err != nil {
+				return err
+			}
+			pdp.SetOutgoingTEIDC(res.TEIDCPlane.MustTEID())
+			pdp.SetOutgoingTEIDU(res.TEIDDataI.MustTEID())
+			if err := pdp.Activate(); err != nil {
+				return err
+			}
+
+			doneCh <- pdp
+			return nil
+		},
+		message.MsgTypeDeletePDPContextResponse: func(c gtpv1.Conn, senderAddr net.Addr, msg message.Message) error {
+			cc := c.(*gtpv1.CPlaneConn)
+
+			pdp, err := cc.GetPDPContextByTEID(msg.TEID(), senderAddr)
+			if err != nil {
+				return err
+			}
+			cc.RemovePDPContext(pdp)
+
+			doneCh <- pdp
+			return nil
+		},
+	})
+
+	teidC := cliConn.NewTEIDCPlane()
+	_, seq, err := cliConn.CreatePDPContext(
+		srvConn.LocalAddr(),
+		ie.NewIMSI(testIMSI),
+		ie.NewSelectionMode(0xf0),
+		ie.NewTEIDDataI(0x11111111),
+		teidC,
+		ie.NewNSAPI(testNSAPI),
+		ie.NewEndUserAddressIPv4(""),
+		ie.NewAccessPointName("some.apn.example"),
+		ie.NewGSNAddress("127.0.0.1"),
+		ie.NewGSNAddress("127.0.0.1"),
+		ie.NewMSISDN("819012345678"),
+		ie.NewQoSProfile([]byte{0x00, 0x00, 0x00, 0x00}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if seq != cliConn.SequenceNumber() {
+		t.Errorf("unexpected sequence: got %d, want %d", seq, cliConn.SequenceNumber())
+	}
+
+	var pdp *gtpv1.PDPContext
+	select {
+	case pdp = <-doneCh:
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for Create PDP Context Response")
+	}
+
+	if got := pdp.IncomingTEIDC(); got != teidC.MustTEID() {
+		t.Errorf("unexpected incoming TEID-C: got %#x, want %#x", got, teidC.MustTEID())
+	}
+	if got := pdp.OutgoingTEIDU(); got != 0x22222222 {
+		t.Errorf("unexpected outgoing TEID-U: got %#x", got)
+	}
+	if got, err := cliConn.GetPDPContextByNSAPI(testIMSI, testNSAPI); err != nil || got != pdp {
+		t.Errorf("failed to look up PDP Context by NSAPI: %v", err)
+	}
+	if got := cliConn.GetPDPContextsByIMSI(testIMSI); len(got) != 1 {
+		t.Errorf("unexpected number of PDP Contexts for IMSI: %d", len(got))
+	}
+	if got := cliConn.PDPContextCount(); got != 1 {
+		t.Errorf("unexpected PDP Context count on client: %d", got)
+	}
+
+	srvPDP, err := srvConn.GetPDPContextByNSAPI(testIMSI, testNSAPI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := srvPDP.OutgoingTEIDC(); got != teidC.MustTEID() {
+		t.Errorf("unexpected outgoing TEID-C on server: got %#x, want %#x", got, teidC.MustTEID())
+	}
+	if got := srvPDP.APN; got != "some.apn.example" {
+		t.Errorf("unexpected APN on server: %s", got)
+	}
+
+	if _, err := cliConn.DeletePDPContext(pdp, ie.NewTeardownInd(true), ie.NewNSAPI(testNSAPI)); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-doneCh:
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for Delete PDP Context Response")
+	}
+
+	if _, err := cliConn.GetPDPContextByNSAPI(testIMSI, testNSAPI); err == nil {
+		t.Error("PDP Context should have been removed from client")
+	}
+	if got := srvConn.PDPContextCount(); got != 0 {
+		t.Errorf("unexpected PDP Context count on server: %d", got)
+	}
+}
+
+func TestPathManagement(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cliConn, srvConn := setupCPlane(ctx, t)
+	srvAddr := srvConn.LocalAddr()
+
+	restartedCh := make(chan uint8, 1)
+	downCh := make(chan net.Addr, 1)
+	cliConn.SetPeerRestartedHandler(func(c gtpv1.Conn, peer net.Addr, counter uint8) {
+		restartedCh <- counter
+	})
+	cliConn.SetPeerDownHandler(func(c gtpv1.Conn, peer net.Addr) {
+		downCh <- peer
+	})
+	cliConn.AddPeer(srvAddr)
+	cliConn.EnablePathManagement(20*time.Millisecond, 3)
+
+	// wait for the first Restart Counter to be known.
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		if counter, ok := cliConn.PeerRestartCounter(srvAddr); ok {
+			if counter != 0 {
+				t.Fatalf("unexpected Restart Counter: got %d, want 0", counter)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for Echo Response")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// restart the server with the incremented Restart Counter.
+	if err := srvConn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	srvConn = gtpv1.NewCPlaneConn(srvAddr, 1)
+	for {
+		// the address may not be released yet.
+		err := srvConn.Listen(ctx)
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	go func() {
+		if err := srvConn.Serve(ctx); err != nil {
+			t.Errorf("error on serving: %v", err)
+		}
+	}()
+
+	select {
+	case counter := <-restartedCh:
+		if counter != 1 {
+			t.Errorf("unexpected Restart Counter: got %d, want 1", counter)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for peer restart to be detected")
+	}
+
+	if err := srvConn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case peer := <-downCh:
+		if peer.String() != srvAddr.String() {
+			t.Errorf("unexpected peer down: %s", peer)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for path down to be detected")
+	}
+	if !cliConn.IsPeerDown(srvAddr) {
+		t.Error("peer should be down")
+	}
+}
+
+func TestRestartCounterWithoutPathManagement(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cliConn, srvConn := setupCPlane(ctx, t)
+	srvAddr := srvConn.LocalAddr()
+
+	// the Restart Counter should be tracked even if the path management is disabled.
+	if _, err := cliConn.EchoRequest(srvAddr); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		if _, ok := cliConn.PeerRestartCounter(srvAddr); ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for Restart Counter to be tracked")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestPathManagementStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cliCtx, cliCancel := context.WithCancel(ctx)
+	cliConn, srvConn := setupCPlane(cliCtx, t)
+	srvAddr := srvConn.LocalAddr()
+
+	downCh := make(chan net.Addr, 1)
+	cliConn.SetPeerDownHandler(func(c gtpv1.Conn, peer net.Addr) {
+		downCh <- peer
+	})
+	cliConn.AddPeer(srvAddr)
+	cliConn.EnablePathManagement(20*time.Millisecond, 3)
+
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		if _, ok := cliConn.PeerRestartCounter(srvAddr); ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for Echo Response")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// the Echo should stop with Serve, otherwise the path is considered down
+	// as the Echo Requests sent on the closed conn are never answered.
+	cliCancel()
+	select {
+	case addr := <-downCh:
+		t.Fatalf("path management still running after ctx is canceled: %s is down", addr)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
@@ -44,3 +44,45 @@ type HandlerNotFoundError struct {
 func (e *HandlerNotFoundError) Error() string {
 	return fmt.Sprintf("no handlers found for incoming message: %s, ignoring", e.MsgType)
 }
+
+// InvalidTEIDError indicates that the TEID value is different from expected one or
+// not registered in CPlaneConn.
+type InvalidTEIDError struct {
+	TEID uint32
+}
+
+// Error returns violating TEID.
+func (e *InvalidTEIDError) Error() string {
+	return fmt.Sprintf("got invalid TEID: %#08x", e.TEID)
+}
+
+// PDPContextNotFoundError indicates that no PDPContext is found by the IMSI and NSAPI.
+type PDPContextNotFoundError struct {
+	IMSI  string
+	NSAPI uint8
+}
+
+// Error returns IMSI and NSAPI used to look up the PDPContext.
+func (e *PDPContextNotFoundError) Error() string {
+	return fmt.Sprintf("no PDP Context found: IMSI: %s, NSAPI: %d", e.IMSI, e.NSAPI)
+}
+
+// RequiredIEMissingError indicates that the IE required is missing.
+type RequiredIEMissingError struct {
+	Type uint8
+}
+
+// Error returns error with missing IE type.
+func (e *RequiredIEMissingError) Error() string {
+	return fmt.Sprintf("required IE missing: %d", e.Type)
+}
+
+// RequiredParameterMissingError indicates that the parameter required is missing.
+type RequiredParameterMissingError struct {
+	Name, Msg string
+}
+
+// Error returns missing parameter with message.
+func (e *RequiredParameterMissingError) Error() string {
+	return fmt.Sprintf("required parameter: %s is missing. %s", e.Name, e.Msg)
+}
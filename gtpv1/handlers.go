@@ -16,6 +16,15 @@ import (
 // HandlerFunc is a handler for specific GTPv1 message.
 type HandlerFunc func(c Conn, senderAddr net.Addr, msg message.Message) error
 
+// PeerDownHandlerFunc is a handler called when the path to the peer is considered
+// down, as the peer did not respond to the Echo Requests.
+type PeerDownHandlerFunc func(c Conn, peer net.Addr)
+
+// PeerRestartedHandlerFunc is a handler called when the peer is found to be restarted,
+// as the Restart Counter in Recovery IE received from the peer has changed.
+// counter is the new Restart Counter value of the peer.
+type PeerRestartedHandlerFunc func(c Conn, peer net.Addr, counter uint8)
+
 type msgHandlerMap struct {
 	syncMap sync.Map
 }
@@ -53,6 +62,15 @@ func newDefaultMsgHandlerMap() *msgHandlerMap {
 	)
 }
 
+func newDefaultCPlaneMsgHandlerMap() *msgHandlerMap {
+	return newMsgHandlerMap(
+		map[uint8]HandlerFunc{
+			message.MsgTypeEchoRequest:  handleEchoRequest,
+			message.MsgTypeEchoResponse: handleEchoResponse,
+		},
+	)
+}
+
 // handleTPDU responds to sender with ErrorIndication by default.
 // By disabling it(DisableErrorIndication), it passes unhandled T-PDU to
 // user, which can be caught by calling ReadFromGTP.
@@ -82,6 +100,10 @@ func handleTPDU(c Conn, senderAddr net.Addr, msg message.Message) error {
 		seq:     pdu.Sequence(),
 		payload: pdu.Payload,
 	}
+	if psc, err := pdu.PDUSessionContainer(); err == nil {
+		tpdu.qfi = psc.QFI
+		tpdu.hasQFI = true
+	}
 
 	// wait for the T-PDU passed to u.tpduCh to be read by ReadFromGTP.
 	// if it got stuck for 3 seconds, it discards the T-PDU received.
@@ -0,0 +1,172 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"encoding/binary"
+)
+
+// NewUDPPortExtensionHeader creates a new ExtensionHeader of UDP Port.
+//
+// §5.2.2.1, TS 29.281
+func NewUDPPortExtensionHeader(port uint16, nextType uint8) *ExtensionHeader {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, port)
+	return NewExtensionHeader(ExtHeaderTypeUDPPort, b, nextType)
+}
+
+// UDPPort returns the UDP Port in ExtensionHeader if the type of ExtensionHeader matches.
+func (e *ExtensionHeader) UDPPort() (uint16, error) {
+	if e.Type != ExtHeaderTypeUDPPort {
+		return 0, &InvalidTypeError{Type: e.Type}
+	}
+	if len(e.Content) < 2 {
+		return 0, ErrTooShortToParse
+	}
+	return binary.BigEndian.Uint16(e.Content[:2]), nil
+}
+
+// NewPDCPPDUNumberExtensionHeader creates a new ExtensionHeader of PDCP PDU Number.
+//
+// §5.2.2.2, TS 29.281
+func NewPDCPPDUNumberExtensionHeader(num uint16, nextType uint8) *ExtensionHeader {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, num)
+	return NewExtensionHeader(ExtHeaderTypePDCPPDUNumber, b, nextType)
+}
+
+// PDCPPDUNumber returns the PDCP PDU Number in ExtensionHeader if the type of ExtensionHeader matches.
+func (e *ExtensionHeader) PDCPPDUNumber() (uint16, error) {
+	if e.Type != ExtHeaderTypePDCPPDUNumber {
+		return 0, &InvalidTypeError{Type: e.Type}
+	}
+	if len(e.Content) < 2 {
+		return 0, ErrTooShortToParse
+	}
+	return binary.BigEndian.Uint16(e.Content[:2]), nil
+}
+
+// NewLongPDCPPDUNumberExtensionHeader creates a new ExtensionHeader of Long PDCP PDU Number,
+// which has the 18-bit PDCP PDU Number.
+//
+// The type of ExtensionHeader is ExtHeaderTypeLongPDCPPDUNumber. Set the Type field to
+// ExtHeaderTypeLongPDCPPDUNumberRequired if the comprehension is required.
+//
+// §5.2.2.2A, TS 29.281
+func NewLongPDCPPDUNumberExtensionHeader(num uint32, nextType uint8) *ExtensionHeader {
+	// 3 octets for the number and 3 spare octets.
+	b := make([]byte, 6)
+	b[0] = uint8(num>>16) & 0x03
+	binary.BigEndian.PutUint16(b[1:3], uint16(num))
+	return NewExtensionHeader(ExtHeaderTypeLongPDCPPDUNumber, b, nextType)
+}
+
+// LongPDCPPDUNumber returns the Long PDCP PDU Number in ExtensionHeader if the type of
+// ExtensionHeader matches.
+func (e *ExtensionHeader) LongPDCPPDUNumber() (uint32, error) {
+	switch e.Type {
+	case ExtHeaderTypeLongPDCPPDUNumber, ExtHeaderTypeLongPDCPPDUNumberRequired:
+		if len(e.Content) < 3 {
+			return 0, ErrTooShortToParse
+		}
+		return uint32(e.Content[0]&0x03)<<16 | uint32(binary.BigEndian.Uint16(e.Content[1:3])), nil
+	default:
+		return 0, &InvalidTypeError{Type: e.Type}
+	}
+}
+
+// NewServiceClassIndicatorExtensionHeader creates a new ExtensionHeader of Service Class Indicator.
+//
+// §5.2.2.5, TS 29.281
+func NewServiceClassIndicatorExtensionHeader(sci uint8, nextType uint8) *ExtensionHeader {
+	return NewExtensionHeader(ExtHeaderTypeServiceClassIndicator, []byte{sci, 0x00}, nextType)
+}
+
+// ServiceClassIndicator returns the Service Class Indicator in ExtensionHeader if the
+// type of ExtensionHeader matches.
+func (e *ExtensionHeader) ServiceClassIndicator() (uint8, error) {
+	if e.Type != ExtHeaderTypeServiceClassIndicator {
+		return 0, &InvalidTypeError{Type: e.Type}
+	}
+	if len(e.Content) < 1 {
+		return 0, ErrTooShortToParse
+	}
+	return e.Content[0], nil
+}
+
+// NewRANContainerExtensionHeader creates a new ExtensionHeader of RAN Container.
+// The container is padded with zeros to make the length a multiple of 4 octets.
+//
+// §5.2.2.4, TS 29.281
+func NewRANContainerExtensionHeader(container []byte, nextType uint8) *ExtensionHeader {
+	return NewExtensionHeader(ExtHeaderTypeRANContainer, padContainer(container), nextType)
+}
+
+// RANContainer returns the RAN Container in ExtensionHeader if the type of ExtensionHeader
+// matches. The padding, if any, is not removed, as the length of the container is not
+// encoded in the ExtensionHeader.
+func (e *ExtensionHeader) RANContainer() ([]byte, error) {
+	if e.Type != ExtHeaderTypeRANContainer {
+		return nil, &InvalidTypeError{Type: e.Type}
+	}
+	return e.Content, nil
+}
+
+// NewXwRANContainerExtensionHeader creates a new ExtensionHeader of Xw RAN Container.
+// The container is padded with zeros to make the length a multiple of 4 octets.
+//
+// §5.2.2.6, TS 29.281
+func NewXwRANContainerExtensionHeader(container []byte, nextType uint8) *ExtensionHeader {
+	return NewExtensionHeader(ExtHeaderTypeXwRANContainer, padContainer(container), nextType)
+}
+
+// XwRANContainer returns the Xw RAN Container in ExtensionHeader if the type of
+// ExtensionHeader matches. The padding, if any, is not removed, as the length of the
+// container is not encoded in the ExtensionHeader.
+func (e *ExtensionHeader) XwRANContainer() ([]byte, error) {
+	if e.Type != ExtHeaderTypeXwRANContainer {
+		return nil, &InvalidTypeError{Type: e.Type}
+	}
+	return e.Content, nil
+}
+
+// NewNRRANContainerExtensionHeader creates a new ExtensionHeader of NR RAN Container.
+// The container is padded with zeros to make the length a multiple of 4 octets.
+//
+// §5.2.2.7, TS 29.281
+func NewNRRANContainerExtensionHeader(container []byte, nextType uint8) *ExtensionHeader {
+	return NewExtensionHeader(ExtHeaderTypeNRRANContainer, padContainer(container), nextType)
+}
+
+// NRRANContainer returns the NR RAN Container in ExtensionHeader if the type of
+// ExtensionHeader matches. The padding, if any, is not removed, as the length of the
+// container is not encoded in the ExtensionHeader.
+func (e *ExtensionHeader) NRRANContainer() ([]byte, error) {
+	if e.Type != ExtHeaderTypeNRRANContainer {
+		return nil, &InvalidTypeError{Type: e.Type}
+	}
+	return e.Content, nil
+}
+
+// NRRANContainerPDUType returns the PDU Type of the NR user plane frame in NR RAN Container,
+// e.g., 0 for DL USER DATA and 1 for DL DATA DELIVERY STATUS defined in TS 38.425.
+func (e *ExtensionHeader) NRRANContainerPDUType() (uint8, error) {
+	c, err := e.NRRANContainer()
+	if err != nil {
+		return 0, err
+	}
+	if len(c) < 1 {
+		return 0, ErrTooShortToParse
+	}
+	return c[0] >> 4, nil
+}
+
+// padContainer returns the container padded with zeros to make the length of
+// ExtensionHeader a multiple of 4 octets, so that it is the same as the one decoded.
+func padContainer(container []byte) []byte {
+	b := make([]byte, pad4Len(len(container)+2)-2)
+	copy(b, container)
+	return b
+}
@@ -0,0 +1,98 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/pascaldekloe/goe/verify"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+)
+
+func TestExtensionHeaderTypes(t *testing.T) {
+	next := message.ExtHeaderTypeNoMoreExtensionHeaders
+	cases := []struct {
+		description string
+		structured  *message.ExtensionHeader
+		serialized  []byte
+		value       func(*message.ExtensionHeader) (interface{}, error)
+		want        interface{}
+	}{
+		{
+			"UDPPort",
+			message.NewUDPPortExtensionHeader(2152, next),
+			[]byte{0x01, 0x08, 0x68, 0x00},
+			func(e *message.ExtensionHeader) (interface{}, error) { return e.UDPPort() },
+			uint16(2152),
+		}, {
+			"PDCPPDUNumber",
+			message.NewPDCPPDUNumberExtensionHeader(0x1234, next),
+			[]byte{0x01, 0x12, 0x34, 0x00},
+			func(e *message.ExtensionHeader) (interface{}, error) { return e.PDCPPDUNumber() },
+			uint16(0x1234),
+		}, {
+			"LongPDCPPDUNumber",
+			message.NewLongPDCPPDUNumberExtensionHeader(0x3ffff, next),
+			[]byte{0x02, 0x03, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00},
+			func(e *message.ExtensionHeader) (interface{}, error) { return e.LongPDCPPDUNumber() },
+			uint32(0x3ffff),
+		}, {
+			"ServiceClassIndicator",
+			message.NewServiceClassIndicatorExtensionHeader(0xab, next),
+			[]byte{0x01, 0xab, 0x00, 0x00},
+			func(e *message.ExtensionHeader) (interface{}, error) { return e.ServiceClassIndicator() },
+			uint8(0xab),
+		}, {
+			"RANContainer",
+			message.NewRANContainerExtensionHeader([]byte{0xde, 0xad, 0xbe, 0xef}, next),
+			[]byte{0x02, 0xde, 0xad, 0xbe, 0xef, 0x00, 0x00, 0x00},
+			func(e *message.ExtensionHeader) (interface{}, error) { return e.RANContainer() },
+			[]byte{0xde, 0xad, 0xbe, 0xef, 0x00, 0x00},
+		}, {
+			"XwRANContainer",
+			message.NewXwRANContainerExtensionHeader([]byte{0xde, 0xad}, next),
+			[]byte{0x01, 0xde, 0xad, 0x00},
+			func(e *message.ExtensionHeader) (interface{}, error) { return e.XwRANContainer() },
+			[]byte{0xde, 0xad},
+		}, {
+			"NRRANContainer",
+			message.NewNRRANContainerExtensionHeader([]byte{0x10, 0x00, 0x00, 0x00, 0x01}, next),
+			[]byte{0x02, 0x10, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00},
+			func(e *message.ExtensionHeader) (interface{}, error) { return e.NRRANContainerPDUType() },
+			uint8(1),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			b, err := c.structured.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := b, c.serialized; !verify.Values(t, "", got, want) {
+				t.Fail()
+			}
+
+			decoded, err := message.ParseExtensionHeader(c.serialized)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Type is not included in the serialized ExtensionHeader.
+			decoded.Type = c.structured.Type
+
+			v, err := c.value(decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := v, c.want; !verify.Values(t, "", got, want) {
+				t.Fail()
+			}
+		})
+	}
+
+	if _, err := message.NewUDPPortExtensionHeader(2152, next).PDCPPDUNumber(); err == nil {
+		t.Error("got no error with the wrong type of ExtensionHeader")
+	}
+}
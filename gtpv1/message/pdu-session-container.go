@@ -0,0 +1,331 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// PDU Type definitions used in PDUSessionContainer.
+const (
+	PDUTypeDLPDUSessionInformation uint8 = 0
+	PDUTypeULPDUSessionInformation uint8 = 1
+)
+
+// PDUSessionContainer represents the content of PDU Session Container ExtensionHeader,
+// which is DL PDU SESSION INFORMATION or UL PDU SESSION INFORMATION defined in
+// §5.5.2, TS 38.415.
+//
+// The optional fields are encoded only when the flag corresponding to each of them is
+// set, and the fields for DL are ignored in UL and vice versa.
+type PDUSessionContainer struct {
+	PDUType uint8
+	QFI     uint8
+
+	// QMP(QoS Monitoring Packet) indicates the presence of the time stamps.
+	QMP bool
+	// SNP(Sequence Number Presence) indicates the presence of the QFI Sequence Number.
+	SNP bool
+
+	// PPP(Paging Policy Presence) indicates the presence of PPI(Paging Policy Indicator).
+	PPP bool
+	RQI bool
+	PPI uint8
+	// MSNP indicates the presence of the DL MBS QFI Sequence Number.
+	MSNP                   bool
+	DLSendingTimeStamp     uint64
+	DLQFISequenceNumber    uint32
+	DLMBSQFISequenceNumber uint32
+
+	DLDelayInd                 bool
+	ULDelayInd                 bool
+	N3N9DelayInd               bool
+	DLSendingTimeStampRepeated uint64
+	DLReceivedTimeStamp        uint64
+	ULSendingTimeStamp         uint64
+	DLDelayResult              uint32
+	ULDelayResult              uint32
+	ULQFISequenceNumber        uint32
+	N3N9DelayResult            uint32
+}
+
+// NewDLPDUSessionInformation creates a new PDUSessionContainer of DL PDU SESSION INFORMATION.
+func NewDLPDUSessionInformation(qfi uint8, rqi bool) *PDUSessionContainer {
+	return &PDUSessionContainer{
+		PDUType: PDUTypeDLPDUSessionInformation,
+		QFI:     qfi,
+		RQI:     rqi,
+	}
+}
+
+// NewULPDUSessionInformation creates a new PDUSessionContainer of UL PDU SESSION INFORMATION.
+func NewULPDUSessionInformation(qfi uint8) *PDUSessionContainer {
+	return &PDUSessionContainer{
+		PDUType: PDUTypeULPDUSessionInformation,
+		QFI:     qfi,
+	}
+}
+
+// NewPDUSessionContainerExtensionHeader creates a new ExtensionHeader of PDU Session Container
+// with the content given.
+func NewPDUSessionContainerExtensionHeader(psc *PDUSessionContainer, nextType uint8) (*ExtensionHeader, error) {
+	// the content is padded to make the length of ExtensionHeader a multiple of 4 octets,
+	// so that it is the same as the one decoded.
+	b := make([]byte, pad4Len(psc.MarshalLen()+2)-2)
+	if err := psc.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return NewExtensionHeader(ExtHeaderTypePDUSessionContainer, b, nextType), nil
+}
+
+// PDUSessionContainer decodes the content of ExtensionHeader as PDUSessionContainer
+// if the type of ExtensionHeader matches.
+func (e *ExtensionHeader) PDUSessionContainer() (*PDUSessionContainer, error) {
+	if e.Type != ExtHeaderTypePDUSessionContainer {
+		return nil, &InvalidTypeError{Type: e.Type}
+	}
+	return ParsePDUSessionContainer(e.Content)
+}
+
+// PDUSessionContainer returns the PDUSessionContainer in the ExtensionHeaders of Header.
+func (h *Header) PDUSessionContainer() (*PDUSessionContainer, error) {
+	for _, eh := range h.ExtensionHeaders {
+		if eh.Type == ExtHeaderTypePDUSessionContainer {
+			return eh.PDUSessionContainer()
+		}
+	}
+	return nil, ErrExtensionHeaderNotFound
+}
+
+// Marshal returns the byte sequence generated from a PDUSessionContainer.
+func (p *PDUSessionContainer) Marshal() ([]byte, error) {
+	b := make([]byte, p.MarshalLen())
+	if err := p.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo puts the byte sequence in the byte array given as b.
+func (p *PDUSessionContainer) MarshalTo(b []byte) error {
+	if len(b) < p.MarshalLen() {
+		return ErrTooShortToMarshal
+	}
+
+	b[0] = p.PDUType << 4
+	switch p.PDUType {
+	case PDUTypeDLPDUSessionInformation:
+		b[0] |= boolToBit(p.QMP)<<3 | boolToBit(p.SNP)<<2 | boolToBit(p.MSNP)<<1
+		b[1] = boolToBit(p.PPP)<<7 | boolToBit(p.RQI)<<6 | p.QFI&0x3f
+		offset := 2
+
+		if p.PPP {
+			b[offset] = p.PPI << 5
+			offset++
+		}
+		if p.QMP {
+			binary.BigEndian.PutUint64(b[offset:offset+8], p.DLSendingTimeStamp)
+			offset += 8
+		}
+		if p.SNP {
+			copy(b[offset:offset+3], utils.Uint32To24(p.DLQFISequenceNumber))
+			offset += 3
+		}
+		if p.MSNP {
+			binary.BigEndian.PutUint32(b[offset:offset+4], p.DLMBSQFISequenceNumber)
+		}
+	case PDUTypeULPDUSessionInformation:
+		b[0] |= boolToBit(p.QMP)<<3 | boolToBit(p.DLDelayInd)<<2 | boolToBit(p.ULDelayInd)<<1 | boolToBit(p.SNP)
+		b[1] = boolToBit(p.N3N9DelayInd)<<7 | p.QFI&0x3f
+		offset := 2
+
+		if p.QMP {
+			binary.BigEndian.PutUint64(b[offset:offset+8], p.DLSendingTimeStampRepeated)
+			binary.BigEndian.PutUint64(b[offset+8:offset+16], p.DLReceivedTimeStamp)
+			binary.BigEndian.PutUint64(b[offset+16:offset+24], p.ULSendingTimeStamp)
+			offset += 24
+		}
+		if p.DLDelayInd {
+			binary.BigEndian.PutUint32(b[offset:offset+4], p.DLDelayResult)
+			offset += 4
+		}
+		if p.ULDelayInd {
+			binary.BigEndian.PutUint32(b[offset:offset+4], p.ULDelayResult)
+			offset += 4
+		}
+		if p.SNP {
+			copy(b[offset:offset+3], utils.Uint32To24(p.ULQFISequenceNumber))
+			offset += 3
+		}
+		if p.N3N9DelayInd {
+			binary.BigEndian.PutUint32(b[offset:offset+4], p.N3N9DelayResult)
+		}
+	default:
+		return &InvalidTypeError{Type: p.PDUType}
+	}
+
+	return nil
+}
+
+// ParsePDUSessionContainer decodes given byte sequence as a PDUSessionContainer.
+func ParsePDUSessionContainer(b []byte) (*PDUSessionContainer, error) {
+	p := &PDUSessionContainer{}
+	if err := p.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UnmarshalBinary sets the values retrieved from byte sequence in PDUSessionContainer.
+//
+// The padding and the fields that are not supported (e.g., New IE Flags in UL) are ignored.
+func (p *PDUSessionContainer) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 2 {
+		return ErrTooShortToParse
+	}
+
+	p.PDUType = b[0] >> 4
+	p.QFI = b[1] & 0x3f
+	offset := 2
+
+	switch p.PDUType {
+	case PDUTypeDLPDUSessionInformation:
+		p.QMP = has4thBit(b[0])
+		p.SNP = has3rdBit(b[0])
+		p.MSNP = has2ndBit(b[0])
+		p.PPP = has8thBit(b[1])
+		p.RQI = has7thBit(b[1])
+
+		if p.PPP {
+			if l < offset+1 {
+				return ErrTooShortToParse
+			}
+			p.PPI = b[offset] >> 5
+			offset++
+		}
+		if p.QMP {
+			if l < offset+8 {
+				return ErrTooShortToParse
+			}
+			p.DLSendingTimeStamp = binary.BigEndian.Uint64(b[offset : offset+8])
+			offset += 8
+		}
+		if p.SNP {
+			if l < offset+3 {
+				return ErrTooShortToParse
+			}
+			p.DLQFISequenceNumber = utils.Uint24To32(b[offset : offset+3])
+			offset += 3
+		}
+		if p.MSNP {
+			if l < offset+4 {
+				return ErrTooShortToParse
+			}
+			p.DLMBSQFISequenceNumber = binary.BigEndian.Uint32(b[offset : offset+4])
+		}
+	case PDUTypeULPDUSessionInformation:
+		p.QMP = has4thBit(b[0])
+		p.DLDelayInd = has3rdBit(b[0])
+		p.ULDelayInd = has2ndBit(b[0])
+		p.SNP = has1stBit(b[0])
+		p.N3N9DelayInd = has8thBit(b[1])
+
+		if p.QMP {
+			if l < offset+24 {
+				return ErrTooShortToParse
+			}
+			p.DLSendingTimeStampRepeated = binary.BigEndian.Uint64(b[offset : offset+8])
+			p.DLReceivedTimeStamp = binary.BigEndian.Uint64(b[offset+8 : offset+16])
+			p.ULSendingTimeStamp = binary.BigEndian.Uint64(b[offset+16 : offset+24])
+			offset += 24
+		}
+		if p.DLDelayInd {
+			if l < offset+4 {
+				return ErrTooShortToParse
+			}
+			p.DLDelayResult = binary.BigEndian.Uint32(b[offset : offset+4])
+			offset += 4
+		}
+		if p.ULDelayInd {
+			if l < offset+4 {
+				return ErrTooShortToParse
+			}
+			p.ULDelayResult = binary.BigEndian.Uint32(b[offset : offset+4])
+			offset += 4
+		}
+		if p.SNP {
+			if l < offset+3 {
+				return ErrTooShortToParse
+			}
+			p.ULQFISequenceNumber = utils.Uint24To32(b[offset : offset+3])
+			offset += 3
+		}
+		if p.N3N9DelayInd {
+			if l < offset+4 {
+				return ErrTooShortToParse
+			}
+			p.N3N9DelayResult = binary.BigEndian.Uint32(b[offset : offset+4])
+		}
+	default:
+		return &InvalidTypeError{Type: p.PDUType}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of PDUSessionContainer, without padding.
+func (p *PDUSessionContainer) MarshalLen() int {
+	l := 2
+	switch p.PDUType {
+	case PDUTypeDLPDUSessionInformation:
+		if p.PPP {
+			l++
+		}
+		if p.QMP {
+			l += 8
+		}
+		if p.SNP {
+			l += 3
+		}
+		if p.MSNP {
+			l += 4
+		}
+	case PDUTypeULPDUSessionInformation:
+		if p.QMP {
+			l += 24
+		}
+		if p.DLDelayInd {
+			l += 4
+		}
+		if p.ULDelayInd {
+			l += 4
+		}
+		if p.SNP {
+			l += 3
+		}
+		if p.N3N9DelayInd {
+			l += 4
+		}
+	}
+	return l
+}
+
+// String returns a PDUSessionContainer fields in human readable format.
+func (p *PDUSessionContainer) String() string {
+	return fmt.Sprintf("{PDUType: %d, QFI: %d, RQI: %v, PPP: %v, PPI: %d, QMP: %v, SNP: %v}",
+		p.PDUType,
+		p.QFI,
+		p.RQI,
+		p.PPP,
+		p.PPI,
+		p.QMP,
+		p.SNP,
+	)
+}
@@ -0,0 +1,144 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/pascaldekloe/goe/verify"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv1/testutils"
+)
+
+func TestPDUSessionContainer(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *message.PDUSessionContainer
+		serialized  []byte
+	}{
+		{
+			"DL",
+			message.NewDLPDUSessionInformation(9, true),
+			[]byte{0x00, 0x49},
+		}, {
+			"DL/WithOptionalFields",
+			&message.PDUSessionContainer{
+				PDUType:             message.PDUTypeDLPDUSessionInformation,
+				QFI:                 9,
+				QMP:                 true,
+				SNP:                 true,
+				PPP:                 true,
+				RQI:                 true,
+				PPI:                 3,
+				DLSendingTimeStamp:  0x0102030405060708,
+				DLQFISequenceNumber: 0x0a0b0c,
+			},
+			[]byte{
+				0x0c, 0xc9, 0x60,
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+				0x0a, 0x0b, 0x0c,
+			},
+		}, {
+			"UL",
+			message.NewULPDUSessionInformation(5),
+			[]byte{0x10, 0x05},
+		}, {
+			"UL/WithOptionalFields",
+			&message.PDUSessionContainer{
+				PDUType:                    message.PDUTypeULPDUSessionInformation,
+				QFI:                        5,
+				QMP:                        true,
+				SNP:                        true,
+				DLDelayInd:                 true,
+				ULDelayInd:                 true,
+				N3N9DelayInd:               true,
+				DLSendingTimeStampRepeated: 0x1111111111111111,
+				DLReceivedTimeStamp:        0x2222222222222222,
+				ULSendingTimeStamp:         0x3333333333333333,
+				DLDelayResult:              0x44444444,
+				ULDelayResult:              0x55555555,
+				ULQFISequenceNumber:        0x666666,
+				N3N9DelayResult:            0x77777777,
+			},
+			[]byte{
+				0x1f, 0x85,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22,
+				0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+				0x44, 0x44, 0x44, 0x44,
+				0x55, 0x55, 0x55, 0x55,
+				0x66, 0x66, 0x66,
+				0x77, 0x77, 0x77, 0x77,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			t.Run("Parse", func(t *testing.T) {
+				v, err := message.ParsePDUSessionContainer(c.serialized)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got, want := v, c.structured; !verify.Values(t, "", got, want) {
+					t.Fail()
+				}
+			})
+
+			t.Run("Marshal", func(t *testing.T) {
+				b, err := c.structured.Marshal()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got, want := b, c.serialized; !verify.Values(t, "", got, want) {
+					t.Fail()
+				}
+			})
+		})
+	}
+}
+
+func TestTPDUWithPDUSessionContainer(t *testing.T) {
+	eh, err := message.NewPDUSessionContainerExtensionHeader(
+		message.NewDLPDUSessionInformation(9, false), message.ExtHeaderTypeNoMoreExtensionHeaders,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pdu := message.NewTPDU(0xdeadbeef, []byte{0xde, 0xad, 0xbe, 0xef})
+	if err := pdu.AddExtensionHeaders(eh); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []testutils.TestCase{
+		{
+			Description: "DL",
+			Structured:  pdu,
+			Serialized: []byte{
+				0x34, 0xff, 0x00, 0x0c, 0xde, 0xad, 0xbe, 0xef,
+				0x00, 0x00, 0x00, 0x85, 0x01, 0x00, 0x09, 0x00,
+				0xde, 0xad, 0xbe, 0xef,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseTPDU(b)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	})
+
+	psc, err := pdu.PDUSessionContainer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if psc.QFI != 9 {
+		t.Errorf("unexpected QFI: got %d, want 9", psc.QFI)
+	}
+}
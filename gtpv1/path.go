@@ -0,0 +1,346 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv1
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv1/ie"
+	"github.com/wmnsk/go-gtp/gtpv1/message"
+)
+
+// pathPeer is the state of the path to a peer.
+type pathPeer struct {
+	addr net.Addr
+
+	// pending is true while the Echo Request sent is not answered.
+	pending bool
+	missed  int
+	down    bool
+
+	restartCounter uint8
+	hasCounter     bool
+}
+
+// pathManager sends Echo Request to each peer periodically, and detects the path
+// failure and the restart of the peer.
+//
+// TS 23.007 Restoration Procedures
+type pathManager struct {
+	mu    sync.Mutex
+	conn  Conn
+	peers map[string]*pathPeer
+
+	echoFunc func(raddr net.Addr) error
+	closeCh  <-chan struct{}
+	stopCh   chan struct{}
+
+	peerDownHandler      PeerDownHandlerFunc
+	peerRestartedHandler PeerRestartedHandlerFunc
+}
+
+func newPathManager(conn Conn, echoFunc func(raddr net.Addr) error, closeCh <-chan struct{}) *pathManager {
+	return &pathManager{
+		conn:     conn,
+		peers:    map[string]*pathPeer{},
+		echoFunc: echoFunc,
+		closeCh:  closeCh,
+	}
+}
+
+// start starts sending Echo Request to each peer every interval. The path is
+// considered down if n Echo Requests in a row are not answered.
+func (p *pathManager) start(interval time.Duration, n int) {
+	p.mu.Lock()
+	if p.stopCh != nil {
+		close(p.stopCh)
+	}
+	stopCh := make(chan struct{})
+	p.stopCh = stopCh
+	p.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-p.closeCh:
+				return
+			case <-ticker.C:
+				p.echo(n)
+			}
+		}
+	}()
+}
+
+func (p *pathManager) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.stopCh != nil {
+		close(p.stopCh)
+		p.stopCh = nil
+	}
+}
+
+func (p *pathManager) echo(n int) {
+	var targets, downs []net.Addr
+
+	p.mu.Lock()
+	for _, peer := range p.peers {
+		if peer.pending {
+			peer.missed++
+			if peer.missed >= n && !peer.down {
+				peer.down = true
+				downs = append(downs, peer.addr)
+			}
+		}
+		peer.pending = true
+		targets = append(targets, peer.addr)
+	}
+	handler := p.peerDownHandler
+	p.mu.Unlock()
+
+	for _, addr := range downs {
+		if handler == nil {
+			logf("path to %s is down", addr)
+			continue
+		}
+		handler(p.conn, addr)
+	}
+
+	for _, addr := range targets {
+		if err := p.echoFunc(addr); err != nil {
+			logf("failed to send Echo Request to %s: %v", addr, err)
+		}
+	}
+}
+
+func (p *pathManager) addPeer(addr net.Addr) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.peers[addr.String()]; !ok {
+		p.peers[addr.String()] = &pathPeer{addr: addr}
+	}
+}
+
+func (p *pathManager) removePeer(addr net.Addr) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.peers, addr.String())
+}
+
+// observe updates the state of the peer with the message received from it.
+// Any message received means the path is working.
+//
+// Unlike the Echo, the Restart Counter is tracked even if the path management is
+// disabled, in the same way as gtpv2. gtpv2 also uses it to clean up the Sessions
+// on the restart of the peer, which is not done in gtpv1.
+func (p *pathManager) observe(addr net.Addr, msg message.Message) {
+	p.mu.Lock()
+	peer, ok := p.peers[addr.String()]
+	if !ok {
+		peer = &pathPeer{addr: addr}
+		p.peers[addr.String()] = peer
+	}
+	peer.pending = false
+	peer.missed = 0
+	peer.down = false
+
+	counter, ok := restartCounterOf(msg)
+	if !ok {
+		p.mu.Unlock()
+		return
+	}
+	restarted := peer.hasCounter && peer.restartCounter != counter
+	peer.restartCounter = counter
+	peer.hasCounter = true
+	handler := p.peerRestartedHandler
+	p.mu.Unlock()
+
+	if !restarted {
+		return
+	}
+	if handler == nil {
+		logf("peer %s has restarted, Restart Counter: %d", addr, counter)
+		return
+	}
+	handler(p.conn, addr, counter)
+}
+
+func (p *pathManager) restartCounter(addr net.Addr) (uint8, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	peer, ok := p.peers[addr.String()]
+	if !ok || !peer.hasCounter {
+		return 0, false
+	}
+	return peer.restartCounter, true
+}
+
+func (p *pathManager) isDown(addr net.Addr) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	peer, ok := p.peers[addr.String()]
+	if !ok {
+		return false
+	}
+	return peer.down
+}
+
+func (p *pathManager) setPeerDownHandler(fn PeerDownHandlerFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.peerDownHandler = fn
+}
+
+func (p *pathManager) setPeerRestartedHandler(fn PeerRestartedHandlerFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.peerRestartedHandler = fn
+}
+
+// restartCounterOf returns the Restart Counter in Recovery IE if the message has it.
+func restartCounterOf(msg message.Message) (uint8, bool) {
+	var i *ie.IE
+	switch m := msg.(type) {
+	case *message.EchoResponse:
+		i = m.Recovery
+	case *message.CreatePDPContextRequest:
+		i = m.Recovery
+	case *message.CreatePDPContextResponse:
+		i = m.Recovery
+	case *message.UpdatePDPContextRequest:
+		i = m.Recovery
+	case *message.UpdatePDPContextResponse:
+		i = m.Recovery
+	}
+	if i == nil {
+		return 0, false
+	}
+
+	counter, err := i.Recovery()
+	if err != nil {
+		return 0, false
+	}
+	return counter, true
+}
+
+// EnablePathManagement starts sending Echo Request to each peer of CPlaneConn every
+// interval. The path to the peer is considered down if n Echo Requests in a row are
+// not answered, and the handler set with SetPeerDownHandler is called.
+//
+// The peers are the ones added with AddPeer and the ones any message is received from.
+// The Restart Counter in Recovery IE received from the peers is tracked regardless of
+// the path management, and the handler set with SetPeerRestartedHandler is called when
+// it changes.
+//
+// It stops when the ctx given to Serve is canceled or CPlaneConn is closed.
+func (c *CPlaneConn) EnablePathManagement(interval time.Duration, n int) {
+	c.paths.start(interval, n)
+}
+
+// DisablePathManagement stops sending Echo Request to the peers.
+func (c *CPlaneConn) DisablePathManagement() {
+	c.paths.stop()
+}
+
+// AddPeer adds a peer to send Echo Request to when the path management is enabled.
+func (c *CPlaneConn) AddPeer(raddr net.Addr) {
+	c.paths.addPeer(raddr)
+}
+
+// RemovePeer removes a peer from the path management.
+func (c *CPlaneConn) RemovePeer(raddr net.Addr) {
+	c.paths.removePeer(raddr)
+}
+
+// PeerRestartCounter returns the last Restart Counter received from the peer.
+// The second returned value is false if no Recovery IE has been received from it.
+func (c *CPlaneConn) PeerRestartCounter(raddr net.Addr) (uint8, bool) {
+	return c.paths.restartCounter(raddr)
+}
+
+// IsPeerDown reports whether the path to the peer is considered down.
+func (c *CPlaneConn) IsPeerDown(raddr net.Addr) bool {
+	return c.paths.isDown(raddr)
+}
+
+// SetPeerDownHandler sets the handler called when the path to the peer is considered
+// down. If not set, it is just logged.
+func (c *CPlaneConn) SetPeerDownHandler(fn PeerDownHandlerFunc) {
+	c.paths.setPeerDownHandler(fn)
+}
+
+// SetPeerRestartedHandler sets the handler called when the peer is found to be restarted.
+// If not set, it is just logged.
+func (c *CPlaneConn) SetPeerRestartedHandler(fn PeerRestartedHandlerFunc) {
+	c.paths.setPeerRestartedHandler(fn)
+}
+
+// EnablePathManagement starts sending Echo Request to each peer of UPlaneConn every
+// interval. The path to the peer is considered down if n Echo Requests in a row are
+// not answered, and the handler set with SetPeerDownHandler is called.
+//
+// The peers are the ones added with AddPeer and the ones any message is received from.
+// Note that T-PDUs forwarded by RelayTo or Kernel GTP-U are not seen by UPlaneConn.
+//
+// TS 29.281 requires the Restart Counter in Echo Response on GTP-U to be zero and
+// ignored, but it is tracked anyway to work with the peers that set it, regardless
+// of the path management.
+//
+// It stops when the ctx given to ListenAndServe is canceled or UPlaneConn is closed.
+func (u *UPlaneConn) EnablePathManagement(interval time.Duration, n int) {
+	u.paths.start(interval, n)
+}
+
+// DisablePathManagement stops sending Echo Request to the peers.
+func (u *UPlaneConn) DisablePathManagement() {
+	u.paths.stop()
+}
+
+// AddPeer adds a peer to send Echo Request to when the path management is enabled.
+func (u *UPlaneConn) AddPeer(raddr net.Addr) {
+	u.paths.addPeer(raddr)
+}
+
+// RemovePeer removes a peer from the path management.
+func (u *UPlaneConn) RemovePeer(raddr net.Addr) {
+	u.paths.removePeer(raddr)
+}
+
+// PeerRestartCounter returns the last Restart Counter received from the peer.
+// The second returned value is false if no Recovery IE has been received from it.
+func (u *UPlaneConn) PeerRestartCounter(raddr net.Addr) (uint8, bool) {
+	return u.paths.restartCounter(raddr)
+}
+
+// IsPeerDown reports whether the path to the peer is considered down.
+func (u *UPlaneConn) IsPeerDown(raddr net.Addr) bool {
+	return u.paths.isDown(raddr)
+}
+
+// SetPeerDownHandler sets the handler called when the path to the peer is considered
+// down. If not set, it is just logged.
+func (u *UPlaneConn) SetPeerDownHandler(fn PeerDownHandlerFunc) {
+	u.paths.setPeerDownHandler(fn)
+}
+
+// SetPeerRestartedHandler sets the handler called when the peer is found to be restarted.
+// If not set, it is just logged.
+func (u *UPlaneConn) SetPeerRestartedHandler(fn PeerRestartedHandlerFunc) {
+	u.paths.setPeerRestartedHandler(fn)
+}
@@ -0,0 +1,146 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv1
+
+import (
+	"net"
+	"sync"
+)
+
+// PDPContext is a GTPv1 PDP Context.
+//
+// A PDPContext is identified by the incoming TEID-C on CPlaneConn, and by the pair of
+// IMSI and NSAPI among the PDP Contexts of the same subscriber.
+type PDPContext struct {
+	mu       sync.Mutex
+	isActive bool
+
+	// peerAddr is a net.Addr of the peer associated with PDPContext.
+	// To avoid calling String() many times, peerAddrString is set when NewPDPContext
+	// and UpdatePeerAddr is called.
+	peerAddr       net.Addr
+	peerAddrString string
+
+	teidCIn, teidCOut uint32
+	teidUIn, teidUOut uint32
+
+	// uAddr is the address of the peer GSN for user traffic.
+	uAddr net.Addr
+
+	IMSI, MSISDN, IMEI string
+	NSAPI              uint8
+	APN                string
+	// MSAddress is the PDP address of the MS in End User Address IE.
+	MSAddress  string
+	ChargingID uint32
+	RATType    uint8
+}
+
+// NewPDPContext creates a new PDPContext with the subscriber's IMSI and NSAPI.
+//
+// This is expected to be used by server-like nodes. Otherwise, use CreatePDPContext(),
+// which sends Create PDP Context Request and returns a new PDPContext.
+func NewPDPContext(peerAddr net.Addr, imsi string, nsapi uint8) *PDPContext {
+	return &PDPContext{
+		mu:             sync.Mutex{},
+		peerAddr:       peerAddr,
+		peerAddrString: peerAddr.String(),
+		IMSI:           imsi,
+		NSAPI:          nsapi,
+	}
+}
+
+// Activate marks a PDPContext active.
+func (p *PDPContext) Activate() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.IMSI == "" {
+		return &RequiredParameterMissingError{"IMSI", "PDPContext must have IMSI set"}
+	}
+
+	p.isActive = true
+	return nil
+}
+
+// Deactivate marks a PDPContext inactive.
+func (p *PDPContext) Deactivate() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.isActive = false
+	return nil
+}
+
+// IsActive reports whether a PDPContext is active or not.
+func (p *PDPContext) IsActive() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.isActive
+}
+
+// PeerAddr returns the address of the peer node associated with PDPContext.
+func (p *PDPContext) PeerAddr() net.Addr {
+	return p.peerAddr
+}
+
+// UpdatePeerAddr updates the address of the peer node associated with PDPContext.
+func (p *PDPContext) UpdatePeerAddr(peer net.Addr) {
+	p.peerAddr = peer
+	p.peerAddrString = peer.String()
+}
+
+// IncomingTEIDC returns the incoming TEID-C associated with PDPContext.
+func (p *PDPContext) IncomingTEIDC() uint32 {
+	return p.teidCIn
+}
+
+// SetIncomingTEIDC sets the incoming TEID-C associated with PDPContext.
+//
+// (*CPlaneConn).RegisterPDPContext does that instead of users but it is safe to call it.
+func (p *PDPContext) SetIncomingTEIDC(teid uint32) {
+	p.teidCIn = teid
+}
+
+// OutgoingTEIDC returns the outgoing TEID-C associated with PDPContext.
+func (p *PDPContext) OutgoingTEIDC() uint32 {
+	return p.teidCOut
+}
+
+// SetOutgoingTEIDC sets the outgoing TEID-C associated with PDPContext.
+func (p *PDPContext) SetOutgoingTEIDC(teid uint32) {
+	p.teidCOut = teid
+}
+
+// IncomingTEIDU returns the incoming TEID-U associated with PDPContext.
+func (p *PDPContext) IncomingTEIDU() uint32 {
+	return p.teidUIn
+}
+
+// SetIncomingTEIDU sets the incoming TEID-U associated with PDPContext.
+func (p *PDPContext) SetIncomingTEIDU(teid uint32) {
+	p.teidUIn = teid
+}
+
+// OutgoingTEIDU returns the outgoing TEID-U associated with PDPContext.
+func (p *PDPContext) OutgoingTEIDU() uint32 {
+	return p.teidUOut
+}
+
+// SetOutgoingTEIDU sets the outgoing TEID-U associated with PDPContext.
+func (p *PDPContext) SetOutgoingTEIDU(teid uint32) {
+	p.teidUOut = teid
+}
+
+// RemoteUPlaneAddress returns the address of the peer GSN for user traffic.
+func (p *PDPContext) RemoteUPlaneAddress() net.Addr {
+	return p.uAddr
+}
+
+// SetRemoteUPlaneAddress sets the address of the peer GSN for user traffic.
+func (p *PDPContext) SetRemoteUPlaneAddress(raddr net.Addr) {
+	p.uAddr = raddr
+}
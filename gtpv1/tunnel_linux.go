@@ -75,11 +75,9 @@ func (u *UPlaneConn) EnableKernelGTP(devname string, role Role) error {
 	u.KernelGTP.enabled = true
 
 	// remove relayed userland tunnels if exists
-	if len(u.relayMap) != 0 {
-		u.mu.Lock()
-		u.relayMap = nil
-		u.mu.Unlock()
-	}
+	u.mu.Lock()
+	u.relayMap = nil
+	u.mu.Unlock()
 
 	return nil
 }
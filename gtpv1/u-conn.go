@@ -30,6 +30,10 @@ type tpduSet struct {
 	teid    uint32
 	seq     uint16
 	payload []byte
+
+	// qfi is the QFI in PDU Session Container, if hasQFI is true.
+	qfi    uint8
+	hasQFI bool
 }
 
 type pktConn interface {
@@ -217,6 +221,14 @@ type UPlaneConn struct {
 
 	errIndEnabled bool
 
+	// RestartCounter is the RestartCounter value in Recovery IE, which represents how many
+	// times the GTPv1-U endpoint is restarted.
+	//
+	// TS 29.281 requires it to be zero, which is the default.
+	RestartCounter uint8
+
+	paths *pathManager
+
 	// for Linux kernel GTP with netlink
 	KernelGTP
 }
@@ -230,7 +242,7 @@ type KernelGTP struct {
 
 // NewUPlaneConn creates a new UPlaneConn used for server. On client side, use DialUPlane instead.
 func NewUPlaneConn(laddr net.Addr) *UPlaneConn {
-	return &UPlaneConn{
+	u := &UPlaneConn{
 		mu:            sync.Mutex{},
 		msgHandlerMap: newDefaultMsgHandlerMap(),
 		iteiMap:       newiteiMap(),
@@ -241,6 +253,9 @@ func NewUPlaneConn(laddr net.Addr) *UPlaneConn {
 
 		errIndEnabled: true,
 	}
+	u.paths = newPathManager(u, u.EchoRequest, u.closeCh)
+
+	return u
 }
 
 // DialUPlane sends Echo Request to raddr to check if the endpoint is alive and returns UPlaneConn.
@@ -256,6 +271,7 @@ func DialUPlane(ctx context.Context, laddr, raddr net.Addr) (*UPlaneConn, error)
 
 		errIndEnabled: true,
 	}
+	u.paths = newPathManager(u, u.EchoRequest, u.closeCh)
 
 	// setup UDPConn first.
 	var err error
@@ -318,18 +334,32 @@ func DialUPlane(ctx context.Context, laddr, raddr net.Addr) (*UPlaneConn, error)
 // This blocks, and returns error only if it face the fatal one. Non-fatal errors are logged
 // with logger. See SetLogger/EnableLogger/DisableLogger for handling of those logs.
 func (u *UPlaneConn) ListenAndServe(ctx context.Context) error {
-	if u.pktConn == nil {
-		var err error
-		u.mu.Lock()
-		u.pktConn, err = newPktConn(u.laddr)
-		u.mu.Unlock()
-		if err != nil {
-			return err
-		}
+	if err := u.Listen(ctx); err != nil {
+		return err
 	}
 	return u.listenAndServe(ctx)
 }
 
+// Listen creates a new GTPv1-U UPlaneConn without serving.
+// Call Serve to start handling the incoming messages.
+func (u *UPlaneConn) Listen(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.pktConn != nil {
+		return nil
+	}
+
+	var err error
+	u.pktConn, err = newPktConn(u.laddr)
+	return err
+}
+
+// Serve starts serving GTPv1-U connection. Listen should be called beforehand.
+func (u *UPlaneConn) Serve(ctx context.Context) error {
+	return u.serve(ctx)
+}
+
 func (u *UPlaneConn) listenAndServe(ctx context.Context) error {
 	// TODO: this func is left for future enhancement.
 	return u.serve(ctx)
@@ -341,6 +371,8 @@ func (u *UPlaneConn) serve(ctx context.Context) error {
 		case <-ctx.Done():
 		case <-u.closed():
 		}
+		// the path management should not keep sending Echo Request on the closed conn.
+		u.paths.stop()
 
 		if u.KernelGTP.enabled {
 			if err := u.KernelGTP.connFile.Close(); err != nil {
@@ -388,7 +420,10 @@ func (u *UPlaneConn) serve(ctx context.Context) error {
 		go func() {
 			// just forward T-PDU instead of passing it to reader if relayer is
 			// configured and the message type is T-PDU.
-			if len(u.relayMap) != 0 && raw[1] == message.MsgTypeTPDU {
+			u.mu.Lock()
+			relaying := len(u.relayMap) != 0
+			u.mu.Unlock()
+			if relaying && raw[1] == message.MsgTypeTPDU {
 				// ignore if the packet size is smaller than minimum header size
 				if n < 11 {
 					return
@@ -471,6 +506,29 @@ func (u *UPlaneConn) ReadFromGTP(p []byte) (n int, addr net.Addr, teid uint32, e
 	}
 }
 
+// ReadFromGTPWithQFI is the same as ReadFromGTP but also returns the QFI in the
+// PDU Session Container extension header. ok is false if the packet does not have
+// a valid PDU Session Container.
+//
+// Note that valid GTP-U packets handled by Kernel can NOT be retrieved by this.
+func (u *UPlaneConn) ReadFromGTPWithQFI(p []byte) (n int, addr net.Addr, teid uint32, qfi uint8, ok bool, err error) {
+	select {
+	case <-u.closed():
+		return
+	case tpdu, chOK := <-u.tpduCh:
+		if !chOK {
+			err = ErrConnNotOpened
+			return
+		}
+		n = copy(p, tpdu.payload)
+		addr = tpdu.raddr
+		teid = tpdu.teid
+		qfi = tpdu.qfi
+		ok = tpdu.hasQFI
+		return
+	}
+}
+
 // WriteTo writes a packet with payload p to addr.
 // WriteTo can be made to time out and return
 // an Error with Timeout() == true after a fixed time limit;
@@ -502,6 +560,38 @@ func (u *UPlaneConn) WriteToGTP(teid uint32, p []byte, addr net.Addr) (n int, er
 	return len(b), nil
 }
 
+// WriteToGTPWithQFI writes a packet with TEID and payload to addr, with the PDU Session
+// Container extension header of DL PDU SESSION INFORMATION that has the QFI given.
+//
+// To send UL PDU SESSION INFORMATION or to set other fields, use
+// WriteToGTPWithPDUSessionContainer instead.
+func (u *UPlaneConn) WriteToGTPWithQFI(teid uint32, qfi uint8, p []byte, addr net.Addr) (n int, err error) {
+	return u.WriteToGTPWithPDUSessionContainer(teid, message.NewDLPDUSessionInformation(qfi, false), p, addr)
+}
+
+// WriteToGTPWithPDUSessionContainer writes a packet with TEID and payload to addr, with
+// the PDU Session Container extension header given.
+func (u *UPlaneConn) WriteToGTPWithPDUSessionContainer(teid uint32, psc *message.PDUSessionContainer, p []byte, addr net.Addr) (n int, err error) {
+	eh, err := message.NewPDUSessionContainerExtensionHeader(psc, message.ExtHeaderTypeNoMoreExtensionHeaders)
+	if err != nil {
+		return
+	}
+	pdu := Encapsulate(teid, p)
+	if err = pdu.AddExtensionHeaders(eh); err != nil {
+		return
+	}
+
+	b, err := pdu.Marshal()
+	if err != nil {
+		return
+	}
+
+	if _, err = u.WriteTo(b, addr); err != nil {
+		return
+	}
+	return len(b), nil
+}
+
 // closed would be used in multiple goroutines.
 // never send struct{}{} to it; instead, use close(u.closeCh).
 func (u *UPlaneConn) closed() <-chan struct{} {
@@ -587,6 +677,8 @@ func (u *UPlaneConn) AddHandlers(funcs map[uint8]HandlerFunc) {
 }
 
 func (u *UPlaneConn) handleMessage(senderAddr net.Addr, msg message.Message) error {
+	u.paths.observe(senderAddr, msg)
+
 	handle, ok := u.msgHandlerMap.load(msg.MessageType())
 	if !ok {
 		return &HandlerNotFoundError{MsgType: msg.MessageTypeName()}
@@ -601,7 +693,7 @@ func (u *UPlaneConn) handleMessage(senderAddr net.Addr, msg message.Message) err
 
 // EchoRequest sends a EchoRequest.
 func (u *UPlaneConn) EchoRequest(raddr net.Addr) error {
-	b, err := message.NewEchoRequest(0, ie.NewRecovery(0)).Marshal()
+	b, err := message.NewEchoRequest(0).Marshal()
 	if err != nil {
 		return err
 	}
@@ -614,7 +706,7 @@ func (u *UPlaneConn) EchoRequest(raddr net.Addr) error {
 
 // EchoResponse sends a EchoResponse.
 func (u *UPlaneConn) EchoResponse(raddr net.Addr) error {
-	b, err := message.NewEchoResponse(0, ie.NewRecovery(0)).Marshal()
+	b, err := message.NewEchoResponse(0, ie.NewRecovery(u.RestartCounter)).Marshal()
 	if err != nil {
 		return err
 	}
@@ -666,7 +758,7 @@ func (u *UPlaneConn) RespondTo(raddr net.Addr, received, toBeSent message.Messag
 
 // Restarts returns the number of restarts in uint8.
 func (u *UPlaneConn) Restarts() uint8 {
-	return 0
+	return u.RestartCounter
 }
 
 // NewFTEID creates a new GTPv2 F-TEID with random TEID value that is unique within UPlaneConn.
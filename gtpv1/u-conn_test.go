@@ -94,6 +94,41 @@ func TestClientWrite(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	select {
+	case <-okCh:
+	case err := <-errCh:
+		t.Fatal(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out while waiting for response to come")
+	}
+
+	// with PDU Session Container.
+	go func(tv *testVal) {
+		n, _, teid, qfi, ok, err := srvConn.ReadFromGTPWithQFI(buf)
+		if err != nil {
+			errCh <- err
+			return
+		}
+
+		if !ok {
+			t.Error("PDU Session Container not found")
+		}
+		if diff := cmp.Diff(qfi, uint8(9)); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(teid, tv.teidOut); diff != "" {
+			t.Error(diff)
+		}
+		if diff := cmp.Diff(buf[:n], tv.payload); diff != "" {
+			t.Error(diff)
+		}
+		okCh <- struct{}{}
+	}(tv)
+
+	if _, err := cliConn.WriteToGTPWithQFI(tv.teidOut, 9, tv.payload, srvConn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+
 	select {
 	case <-okCh:
 		return
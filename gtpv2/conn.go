@@ -50,11 +50,40 @@ type Conn struct {
 	// RestartCounter is the RestartCounter value in Recovery IE, which represents how many
 	// times the GTPv2-C endpoint is restarted.
 	RestartCounter uint8
+
+	// t3 and n3 are the T3-RESPONSE timer and the N3-REQUESTS counter used for the
+	// reliable delivery of signalling messages. Zero t3 means it is disabled.
+	//
+	// TS29.274 7.6  Reliable Delivery of Signalling Messages
+	t3 time.Duration
+	n3 int
+	*transactionMap
+	*requestCache
+	notRespondingHandler NotRespondingHandlerFunc
+
+	paths *pathManager
+
+	// sessionCleanupEnabled makes Conn remove the Sessions on the restart of the peer.
+	//
+	// TS 23.007 Restoration Procedures
+	sessionCleanupEnabled bool
+	sessionCleanupHandler SessionCleanupHandlerFunc
+	restartNotifyConn     *Conn
+	restartNotifySGWIP    string
+
+	// overload keeps the overload and load information of the peers.
+	//
+	// TS 29.274 12 GTP-C Load and Overload Control Mechanism
+	overload *overloadManager
+
+	// s11uTunnels is the S11-U tunnels looked up by the UPlaneConn and the TEID
+	// allocated on it, to avoid scanning the Sessions for every T-PDU received.
+	s11uTunnels sync.Map
 }
 
 // NewConn creates a new Conn used for server. On client side, use Dial instead.
 func NewConn(laddr net.Addr, localIfType, counter uint8) *Conn {
-	return &Conn{
+	c := &Conn{
 		mu:                sync.Mutex{},
 		laddr:             laddr,
 		imsiSessionMap:    newimsiSessionMap(),
@@ -65,7 +94,13 @@ func NewConn(laddr net.Addr, localIfType, counter uint8) *Conn {
 		msgHandlerMap:     newDefaultMsgHandlerMap(),
 		sequence:          0,
 		RestartCounter:    counter,
+		transactionMap:    newTransactionMap(),
+		requestCache:      newRequestCache(),
+		overload:          newOverloadManager(localIfType),
 	}
+	c.paths = newPathManager(c, c.echoRequest, c.closeCh)
+
+	return c
 }
 
 // Dial sends Echo Request to raddr to check if the endpoint is alive and returns Conn.
@@ -86,7 +121,11 @@ func Dial(ctx context.Context, laddr, raddr net.Addr, localIfType, counter uint8
 		msgHandlerMap:     newDefaultMsgHandlerMap(),
 		sequence:          0,
 		RestartCounter:    counter,
+		transactionMap:    newTransactionMap(),
+		requestCache:      newRequestCache(),
+		overload:          newOverloadManager(localIfType),
 	}
+	c.paths = newPathManager(c, c.echoRequest, c.closeCh)
 
 	// setup underlying connection first.
 	// not using net.Dial, as it binds src/dst IP:Port, which makes it harder to
@@ -170,6 +209,8 @@ func (c *Conn) Serve(ctx context.Context) error {
 		case <-ctx.Done():
 		case <-c.closed():
 		}
+		// the path management should not keep sending Echo Request on the closed conn.
+		c.paths.stop()
 
 		if err := c.pktConn.Close(); err != nil {
 			logf("error closing the underlying conn: %s", err)
@@ -201,6 +242,14 @@ func (c *Conn) Serve(ctx context.Context) error {
 			if err := c.handleMessage(raddr, msg); err != nil {
 				logf("error handling message on Conn %s: %v", c.LocalAddr(), err)
 			}
+
+			// the piggybacked initial message is handled after the triggering response.
+			// TS 29.274 5.5.1 Piggybacking of Initial Messages
+			if p := msg.Piggybacked(); p != nil {
+				if err := c.handleMessage(raddr, p); err != nil {
+					logf("error handling piggybacked message on Conn %s: %v", c.LocalAddr(), err)
+				}
+			}
 		}()
 	}
 }
@@ -316,6 +365,12 @@ func (c *Conn) handleMessage(senderAddr net.Addr, msg message.Message) error {
 			return fmt.Errorf("failed to validate %s: %w", msg.MessageTypeName(), err)
 		}
 	}
+	c.paths.observe(senderAddr, msg)
+	c.overload.observe(senderAddr, msg)
+
+	if discard, err := c.checkTransaction(senderAddr, msg); discard {
+		return err
+	}
 
 	handle, ok := c.msgHandlerMap.load(msg.MessageType())
 	if !ok {
@@ -375,23 +430,230 @@ func (c *Conn) validate(senderAddr net.Addr, msg message.Message) error {
 	return nil
 }
 
+// EnableReliableDelivery turns on the reliable delivery of signalling messages
+// defined in TS 29.274 7.6.
+//
+// Once enabled, the initial messages sent with SendMessageTo (and the methods using it,
+// such as CreateSession) are retransmitted every t3 until the triggered message comes
+// from the peer, up to n3 times. If the peer still does not respond, the handler set
+// with SetNotRespondingHandler is called with *PeerNotRespondingError.
+//
+// Also, the initial messages received from the peer are remembered for t3*(n3+1), and
+// the duplicated one is not passed to the HandlerFunc. Instead, the response sent
+// with RespondTo is retransmitted, or just discarded if not responded yet.
+// The triggered messages duplicated are discarded as well.
+func (c *Conn) EnableReliableDelivery(t3 time.Duration, n3 int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t3 = t3
+	c.n3 = n3
+}
+
+// DisableReliableDelivery turns off the reliable delivery of signalling messages.
+// It is disabled by default.
+//
+// The initial messages already sent are retransmitted until they get responses
+// or the retransmission count reaches N3.
+func (c *Conn) DisableReliableDelivery() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t3 = 0
+	c.n3 = 0
+}
+
+// SetNotRespondingHandler sets the handler called when the peer does not respond
+// to the initial message after the retransmissions. If nothing is set, it is just logged.
+//
+// See EnableReliableDelivery for details.
+func (c *Conn) SetNotRespondingHandler(fn NotRespondingHandlerFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.notRespondingHandler = fn
+}
+
+func (c *Conn) retransmissionParams() (time.Duration, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t3, c.n3
+}
+
+// checkTransaction checks if the message received is a duplicate or not, and
+// completes the transaction if the triggered message comes.
+// It returns true if the message should not be passed to the HandlerFunc.
+func (c *Conn) checkTransaction(senderAddr net.Addr, msg message.Message) (bool, error) {
+	t3, n3 := c.retransmissionParams()
+	if t3 > 0 && isInitialMessage(msg.MessageType()) {
+		res, ok := c.requestCache.tryStore(senderAddr, msg, t3*time.Duration(n3+1))
+		if !ok {
+			// response is nil if the original one is still being handled.
+			b := res.response()
+			if b == nil {
+				return true, nil
+			}
+			if _, err := c.WriteTo(b, senderAddr); err != nil {
+				return true, fmt.Errorf("failed to retransmit response to %s: %w", msg.MessageTypeName(), err)
+			}
+			return true, nil
+		}
+	}
+
+	// the Request with the same Sequence Number as the Command but with the
+	// different PTI is not triggered by it, and passed to the HandlerFunc.
+	tx, ok := c.transactionMap.load(senderAddr, msg.Sequence())
+	if !ok || !tx.matches(msg) {
+		return false, nil
+	}
+
+	// the transaction is already completed by the same triggered message.
+	if !tx.complete(msg, nil) {
+		return true, nil
+	}
+
+	// the triggered message is returned by Request instead.
+	return tx.waiting, nil
+}
+
+// retransmit sends the initial message again at intervals of t3 until the
+// transaction is completed, and notifies the failure if it is not completed
+// after n3 retransmissions.
+func (c *Conn) retransmit(tx *transaction, t3 time.Duration, n3 int) {
+	seq := tx.msg.Sequence()
+
+	// keep the transaction for a while after it is done to discard the
+	// triggered messages duplicated by the retransmission.
+	defer func() {
+		time.AfterFunc(t3, func() {
+			c.transactionMap.delete(tx.raddr, seq)
+		})
+	}()
+
+	timer := time.NewTimer(t3)
+	defer timer.Stop()
+	for retries := 0; ; retries++ {
+		select {
+		case <-tx.doneCh:
+			return
+		case <-c.closed():
+			tx.complete(nil, net.ErrClosed)
+			return
+		case <-timer.C:
+		}
+
+		if retries >= n3 {
+			err := &PeerNotRespondingError{Peer: tx.raddr, Msg: tx.msg, Retries: retries}
+			if !tx.complete(nil, err) {
+				return
+			}
+
+			// the error is returned by Request instead.
+			if tx.waiting {
+				return
+			}
+
+			c.mu.Lock()
+			fn := c.notRespondingHandler
+			c.mu.Unlock()
+			if fn == nil {
+				logf("%s", err)
+				return
+			}
+			fn(c, err)
+			return
+		}
+
+		if _, err := c.WriteTo(tx.payload, tx.raddr); err != nil {
+			logf("failed to retransmit %s: %s", tx.msg.MessageTypeName(), err)
+		}
+		timer.Reset(t3)
+	}
+}
+
 // SendMessageTo sends a message to addr.
 // Unlike WriteTo, it sets the Sequence Number properly and returns the one used in the message.
+//
+// If the reliable delivery is enabled and the message is an initial message, it is
+// retransmitted until the response comes. See EnableReliableDelivery for details.
+//
+// If the overload control is enabled, the initial message toward an overloaded peer
+// may be discarded with *PeerOverloadedError, and the returned Sequence Number is zero.
+// See EnableOverloadControl for details.
 func (c *Conn) SendMessageTo(msg message.Message, addr net.Addr) (uint32, error) {
+	_, seq, err := c.sendMessageTo(msg, addr, false)
+	return seq, err
+}
+
+// Request sends an initial message to addr and waits for the triggered message
+// to come from addr with the same Sequence Number.
+//
+// The triggered message is returned to the caller and is NOT passed to the HandlerFunc
+// registered for its type. Any number of Requests can be in flight concurrently, and
+// the responses can come in any order.
+//
+// It waits until ctx is done if the reliable delivery is disabled. Otherwise, the message
+// is retransmitted as described in EnableReliableDelivery and *PeerNotRespondingError
+// is returned if no response comes after all.
+func (c *Conn) Request(ctx context.Context, msg message.Message, addr net.Addr) (message.Message, error) {
+	if !isInitialMessage(msg.MessageType()) {
+		return nil, &UnexpectedTypeError{Msg: msg}
+	}
+
+	tx, seq, err := c.sendMessageTo(msg, addr, true)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-tx.doneCh:
+	case <-ctx.Done():
+		// the result is kept if the transaction is completed at the same time.
+		tx.complete(nil, ctx.Err())
+	}
+
+	// the transaction is removed by retransmit if it is running.
+	if !tx.retransmitting {
+		c.transactionMap.delete(addr, seq)
+	}
+	return tx.result()
+}
+
+func (c *Conn) sendMessageTo(msg message.Message, addr net.Addr, waiting bool) (*transaction, uint32, error) {
+	if metric, throttled := c.overload.throttle(addr, msg); throttled {
+		// the message is discarded without the Sequence Number assigned.
+		return nil, 0, &PeerOverloadedError{Peer: addr, Msg: msg, Metric: metric}
+	}
+
 	seq := c.IncSequence()
+	if isCommandMessage(msg.MessageType()) {
+		seq |= commandSequenceFlag
+	}
 	msg.SetSequenceNumber(seq)
 
 	payload, err := message.Marshal(msg)
 	if err != nil {
 		seq = c.DecSequence()
-		return seq, fmt.Errorf("failed to send %T: %w", msg, err)
+		return nil, seq, fmt.Errorf("failed to send %T: %w", msg, err)
+	}
+
+	t3, n3 := c.retransmissionParams()
+	var tx *transaction
+	if waiting || (t3 > 0 && isInitialMessage(msg.MessageType())) {
+		tx = newTransaction(addr, msg, payload, waiting)
+		tx.retransmitting = t3 > 0
+		c.transactionMap.store(addr, seq, tx)
 	}
 
 	if _, err := c.WriteTo(payload, addr); err != nil {
+		if tx != nil {
+			c.transactionMap.delete(addr, seq)
+		}
 		seq = c.DecSequence()
-		return seq, fmt.Errorf("failed to send %T: %w", msg, err)
+		return nil, seq, fmt.Errorf("failed to send %T: %w", msg, err)
 	}
-	return seq, nil
+
+	if tx != nil && tx.retransmitting {
+		go c.retransmit(tx, t3, n3)
+	}
+	return tx, seq, nil
 }
 
 // IncSequence increments the SequenceNumber associated with Conn.
@@ -400,8 +662,8 @@ func (c *Conn) IncSequence() uint32 {
 	defer c.mu.Unlock()
 	c.sequence++
 
-	// SequenceNumber is 3-octet long
-	if c.sequence > 0xffffff {
+	// SequenceNumber is 3-octet long, and the MSB is reserved for the Command messages.
+	if c.sequence >= commandSequenceFlag {
 		c.sequence = 0
 	}
 
@@ -436,6 +698,11 @@ func (c *Conn) EchoRequest(raddr net.Addr) (uint32, error) {
 	return seq, nil
 }
 
+func (c *Conn) echoRequest(raddr net.Addr) error {
+	_, err := c.EchoRequest(raddr)
+	return err
+}
+
 // EchoResponse sends a EchoResponse in response to the EchoRequest.
 func (c *Conn) EchoResponse(raddr net.Addr, req message.Message) error {
 	res := message.NewEchoResponse(0, ie.NewRecovery(c.RestartCounter))
@@ -446,6 +713,22 @@ func (c *Conn) EchoResponse(raddr net.Addr, req message.Message) error {
 	return nil
 }
 
+// PGWRestartNotification sends a PGWRestartNotification to raddr, with the IP addresses
+// of the restarted P-GW and S-GW given.
+func (c *Conn) PGWRestartNotification(raddr net.Addr, pgwIP, sgwIP string) (uint32, error) {
+	msg := message.NewPGWRestartNotification(
+		0, 0,
+		ie.NewIPAddress(pgwIP),
+		ie.NewIPAddress(sgwIP).WithInstance(1),
+	)
+
+	seq, err := c.SendMessageTo(msg, raddr)
+	if err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
 // VersionNotSupportedIndication sends VersionNotSupportedIndication message
 // in response to any kind of message.Message.
 func (c *Conn) VersionNotSupportedIndication(raddr net.Addr, req message.Message) error {
@@ -642,21 +925,100 @@ func (c *Conn) DeleteBearer(teid uint32, sess *Session, ie ...*ie.IE) (uint32, e
 	return seq, nil
 }
 
+// BearerResourceCommand sends a BearerResourceCommand with TEID and IEs given, and waits
+// for the Create/Update/Delete Bearer Request triggered by it.
+//
+// The command is sent with the MSB of the Sequence Number set, and the triggered request
+// is returned only if it has the same Sequence Number and PTI as the command. Others are
+// passed to the HandlerFunc as usual. As the triggered request is NOT passed to the
+// HandlerFunc registered for its type, the caller should send the response to it,
+// typically with RespondTo.
+// If the command is rejected with Bearer Resource Failure Indication, it is returned
+// together with *CauseNotOKError.
+func (c *Conn) BearerResourceCommand(ctx context.Context, teid uint32, sess *Session, ies ...*ie.IE) (message.Message, error) {
+	msg := message.NewBearerResourceCommand(teid, 0, ies...)
+	if msg.PTI == nil {
+		return nil, &RequiredIEMissingError{Type: ie.ProcedureTransactionID}
+	}
+	pti, err := msg.PTI.ProcedureTransactionID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Request(ctx, msg, sess.peerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the PTI of the triggered request is checked on receiving it.
+	switch m := res.(type) {
+	case *message.CreateBearerRequest, *message.UpdateBearerRequest, *message.DeleteBearerRequest:
+		return res, nil
+	case *message.BearerResourceFailureIndication:
+		if m.Cause == nil {
+			return m, &RequiredIEMissingError{Type: ie.Cause}
+		}
+		cause, err := m.Cause.Cause()
+		if err != nil {
+			return m, err
+		}
+		return m, &CauseNotOKError{
+			MsgType: m.MessageTypeName(),
+			Cause:   cause,
+			Msg:     fmt.Sprintf("Bearer Resource Command with PTI: %d is rejected", pti),
+		}
+	default:
+		return res, &UnexpectedTypeError{Msg: res}
+	}
+}
+
 // RespondTo sends a message(specified with "toBeSent" param) in response to a message
 // (specified with "received" param).
 //
 // This exists to make it easier to handle SequenceNumber.
+// If toBeSent has a piggybacked message set with SetPiggybacked, it is sent together
+// with the new SequenceNumber of Conn. If the reliable delivery is enabled, it is
+// retransmitted together with toBeSent until its triggered message comes, in the same
+// way as the initial message sent with SendMessageTo.
 func (c *Conn) RespondTo(raddr net.Addr, received, toBeSent message.Message) error {
 	toBeSent.SetSequenceNumber(received.Sequence())
-	b := make([]byte, toBeSent.MarshalLen())
 
-	if err := toBeSent.MarshalTo(b); err != nil {
+	// the piggybacked initial message has its own sequence number.
+	p := toBeSent.Piggybacked()
+	var pseq uint32
+	if p != nil {
+		pseq = c.IncSequence()
+		p.SetSequenceNumber(pseq)
+	}
+
+	b, err := message.Marshal(toBeSent)
+	if err != nil {
 		return err
 	}
 
+	// keep the response to retransmit it on receiving the duplicated message.
+	t3, n3 := c.retransmissionParams()
+	if t3 > 0 && isInitialMessage(received.MessageType()) {
+		c.requestCache.setResponse(raddr, received, b, t3*time.Duration(n3+1))
+	}
+
+	var tx *transaction
+	if p != nil && t3 > 0 && isInitialMessage(p.MessageType()) {
+		tx = newTransaction(raddr, p, b, false)
+		tx.retransmitting = true
+		c.transactionMap.store(raddr, pseq, tx)
+	}
+
 	if _, err := c.WriteTo(b, raddr); err != nil {
+		if tx != nil {
+			c.transactionMap.delete(raddr, pseq)
+		}
 		return err
 	}
+
+	if tx != nil {
+		go c.retransmit(tx, t3, n3)
+	}
 	return nil
 }
 
@@ -706,6 +1068,9 @@ func (c *Conn) RegisterSession(itei uint32, session *Session) {
 // RemoveSession removes a session registered in a Conn.
 func (c *Conn) RemoveSession(session *Session) {
 	c.imsiSessionMap.delete(session.IMSI)
+	if err := c.DeleteS11UTunnel(session); err != nil {
+		logf("failed to delete S11-U tunnel of session: %+v", err)
+	}
 
 	itei, err := session.GetTEID(c.localIfType)
 	if err != nil { // if incoming TEID could not be found for some reason
@@ -5,10 +5,14 @@
 package gtpv2_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math"
 	"net"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -217,3 +221,968 @@ func TestCreateSession(t *testing.T) {
 		t.Fatal("timed out while waiting for validating Create Session Response")
 	}
 }
+
+func listenLoopback(t *testing.T) net.PacketConn {
+	t.Helper()
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = pc.Close() })
+	return pc
+}
+
+func newLoopbackConn(ctx context.Context, t *testing.T, ifType uint8) *gtpv2.Conn {
+	t.Helper()
+
+	laddr, err := net.ResolveUDPAddr("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := gtpv2.NewConn(laddr, ifType, 0)
+	if err := conn.Listen(ctx); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := conn.Serve(ctx); err != nil {
+			log.Println(err)
+		}
+	}()
+	return conn
+}
+
+func TestRetransmission(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	cliConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11MMEGTPC)
+	cliConn.EnableReliableDelivery(100*time.Millisecond, 3)
+
+	rspOK := make(chan struct{}, 2)
+	cliConn.AddHandler(
+		message.MsgTypeCreateSessionResponse,
+		func(c *gtpv2.Conn, srvAddr net.Addr, msg message.Message) error {
+			rspOK <- struct{}{}
+			return nil
+		},
+	)
+
+	seq, err := cliConn.SendMessageTo(message.NewCreateSessionRequest(0, 0, ie.NewIMSI("123451234567890")), peer.LocalAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ignore the first one and respond to the retransmitted one twice.
+	buf := make([]byte, 1500)
+	var raddr net.Addr
+	for i := 0; i < 2; i++ {
+		if err := peer.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		var n int
+		n, raddr, err = peer.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("retransmitted message not received: %s", err)
+		}
+		msg, err := message.Parse(buf[:n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg.Sequence() != seq {
+			t.Errorf("invalid sequence number. got: %d, want: %d", msg.Sequence(), seq)
+		}
+	}
+
+	rsp, err := message.NewCreateSessionResponse(0, seq, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := peer.WriteTo(rsp, raddr); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	select {
+	case <-rspOK:
+	case <-time.After(time.Second):
+		t.Fatal("timed out while waiting for Create Session Response")
+	}
+	select {
+	case <-rspOK:
+		t.Error("duplicated Create Session Response is passed to handler")
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestPeerNotResponding(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	cliConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11MMEGTPC)
+	cliConn.EnableReliableDelivery(50*time.Millisecond, 2)
+
+	errCh := make(chan *gtpv2.PeerNotRespondingError, 1)
+	cliConn.SetNotRespondingHandler(func(c *gtpv2.Conn, err *gtpv2.PeerNotRespondingError) {
+		errCh <- err
+	})
+
+	seq, err := cliConn.EchoRequest(peer.LocalAddr())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-errCh:
+		if got, want := err.Msg.Sequence(), seq; got != want {
+			t.Errorf("invalid sequence number. got: %d, want: %d", got, want)
+		}
+		if got, want := err.Retries, 2; got != want {
+			t.Errorf("invalid retries. got: %d, want: %d", got, want)
+		}
+		if got, want := err.Peer.String(), peer.LocalAddr().String(); got != want {
+			t.Errorf("invalid peer. got: %s, want: %s", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out while waiting for PeerNotRespondingError")
+	}
+}
+
+func TestDuplicatedRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	srvConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11S4SGWGTPC)
+	srvConn.EnableReliableDelivery(time.Second, 3)
+
+	var handled int32
+	srvConn.AddHandler(
+		message.MsgTypeDeleteSessionRequest,
+		func(c *gtpv2.Conn, cliAddr net.Addr, msg message.Message) error {
+			atomic.AddInt32(&handled, 1)
+			return c.RespondTo(
+				cliAddr, msg,
+				message.NewDeleteSessionResponse(0, 0, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil)),
+			)
+		},
+	)
+
+	req, err := message.NewDeleteSessionRequest(0, 0x123456, ie.NewEPSBearerID(5)).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 1500)
+	for i := 0; i < 2; i++ {
+		if _, err := peer.WriteTo(req, srvConn.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		if err := peer.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, _, err := peer.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("response not received: %s", err)
+		}
+		msg, err := message.Parse(buf[:n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := msg.(*message.DeleteSessionResponse); !ok {
+			t.Fatalf("got unexpected type of message: %T", msg)
+		}
+		if got, want := msg.Sequence(), uint32(0x123456); got != want {
+			t.Errorf("invalid sequence number. got: %d, want: %d", got, want)
+		}
+	}
+
+	if got := atomic.LoadInt32(&handled); got != 1 {
+		t.Errorf("duplicated request is handled %d times", got)
+	}
+}
+
+func TestRequest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	cliConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11MMEGTPC)
+	cliConn.AddHandler(
+		message.MsgTypeModifyBearerResponse,
+		func(c *gtpv2.Conn, srvAddr net.Addr, msg message.Message) error {
+			t.Error("response to Request is passed to handler")
+			return nil
+		},
+	)
+
+	const numReqs = 100
+
+	// respond to all the requests in reverse order, with the Private Extension
+	// to identify which request it is for.
+	go func() {
+		type received struct {
+			seq   uint32
+			id    []byte
+			raddr net.Addr
+		}
+		reqs := make([]received, 0, numReqs)
+		buf := make([]byte, 1500)
+		for len(reqs) < numReqs {
+			n, raddr, err := peer.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			msg, err := message.ParseModifyBearerRequest(buf[:n])
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			id, err := msg.PrivateExtension.PrivateExtension()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			reqs = append(reqs, received{msg.Sequence(), append([]byte{}, id...), raddr})
+		}
+
+		for i := len(reqs) - 1; i >= 0; i-- {
+			r := reqs[i]
+			b, err := message.NewModifyBearerResponse(
+				0, r.seq, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+				ie.NewPrivateExtension(10415, r.id),
+			).Marshal()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if _, err := peer.WriteTo(b, r.raddr); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+
+	reqCtx, reqCancel := context.WithTimeout(ctx, 3*time.Second)
+	defer reqCancel()
+
+	errCh := make(chan error, numReqs)
+	for i := 0; i < numReqs; i++ {
+		go func(id uint8) {
+			rsp, err := cliConn.Request(
+				reqCtx, message.NewModifyBearerRequest(0, 0, ie.NewPrivateExtension(10415, []byte{id})),
+				peer.LocalAddr(),
+			)
+			if err != nil {
+				errCh <- err
+				return
+			}
+
+			mbRsp, ok := rsp.(*message.ModifyBearerResponse)
+			if !ok {
+				errCh <- fmt.Errorf("got unexpected type of message: %T", rsp)
+				return
+			}
+			got, err := mbRsp.PrivateExtension.PrivateExtension()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			if got[0] != id {
+				errCh <- fmt.Errorf("got response for wrong request. got: %d, want: %d", got[0], id)
+				return
+			}
+			errCh <- nil
+		}(uint8(i))
+	}
+
+	for i := 0; i < numReqs; i++ {
+		if err := <-errCh; err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestRequestCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	cliConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11MMEGTPC)
+
+	reqCtx, reqCancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer reqCancel()
+
+	if _, err := cliConn.Request(reqCtx, message.NewEchoRequest(0, ie.NewRecovery(0)), peer.LocalAddr()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("unexpected error. got: %v, want: %v", err, context.DeadlineExceeded)
+	}
+
+	cliConn.EnableReliableDelivery(50*time.Millisecond, 1)
+	_, err := cliConn.Request(ctx, message.NewEchoRequest(0, ie.NewRecovery(0)), peer.LocalAddr())
+	var nrErr *gtpv2.PeerNotRespondingError
+	if !errors.As(err, &nrErr) {
+		t.Errorf("unexpected error. got: %v, want: %T", err, nrErr)
+	}
+
+	if _, err := cliConn.Request(ctx, message.NewEchoResponse(0, ie.NewRecovery(0)), peer.LocalAddr()); err == nil {
+		t.Error("Request with triggered message should fail")
+	}
+}
+
+func TestBearerResourceCommand(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	cliConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11MMEGTPC)
+
+	// only the requests not triggered by the commands should come here.
+	handledCh := make(chan message.Message, 2)
+	handler := func(c *gtpv2.Conn, srvAddr net.Addr, msg message.Message) error {
+		handledCh <- msg
+		return nil
+	}
+	cliConn.AddHandlers(map[uint8]gtpv2.HandlerFunc{
+		message.MsgTypeCreateBearerRequest: handler,
+		message.MsgTypeUpdateBearerRequest: handler,
+	})
+
+	// respond to the commands depending on the PTI; accept 1, reject 2, and
+	// send the requests not triggered by the command for 3.
+	go func() {
+		buf := make([]byte, 1500)
+		for {
+			n, raddr, err := peer.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			cmd, err := message.ParseBearerResourceCommand(buf[:n])
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if cmd.Sequence()&0x800000 == 0 {
+				t.Errorf("MSB of Sequence Number is not set in command: %#x", cmd.Sequence())
+			}
+
+			var res []message.Message
+			switch cmd.PTI.MustProcedureTransactionID() {
+			case 1:
+				res = append(res, message.NewCreateBearerRequest(
+					0, cmd.Sequence(), ie.NewProcedureTransactionID(1), ie.NewEPSBearerID(5),
+				))
+			case 2:
+				res = append(res, message.NewBearerResourceFailureIndication(
+					0, cmd.Sequence(), ie.NewCause(gtpv2.CauseServiceNotSupported, 0, 0, 0, nil),
+					ie.NewEPSBearerID(5), ie.NewProcedureTransactionID(2),
+				))
+			default:
+				// the wrong PTI, and the one initiated by the peer with the same
+				// Sequence Number but without the MSB.
+				res = append(res,
+					message.NewUpdateBearerRequest(0, cmd.Sequence(), ie.NewProcedureTransactionID(4)),
+					message.NewCreateBearerRequest(0, cmd.Sequence()&0x7fffff, ie.NewEPSBearerID(6)),
+				)
+			}
+			for _, r := range res {
+				b, err := message.Marshal(r)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if _, err := peer.WriteTo(b, raddr); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}
+	}()
+
+	reqCtx, reqCancel := context.WithTimeout(ctx, 3*time.Second)
+	defer reqCancel()
+
+	sess := gtpv2.NewSession(peer.LocalAddr(), &gtpv2.Subscriber{IMSI: "123451234567890"})
+	command := func(pti uint8) (message.Message, error) {
+		return cliConn.BearerResourceCommand(
+			reqCtx, 0x11111111, sess,
+			ie.NewEPSBearerID(5),
+			ie.NewProcedureTransactionID(pti),
+			ie.NewTrafficAggregateDescriptionNoTFTOperation(),
+		)
+	}
+
+	res, err := command(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := res.(*message.CreateBearerRequest); !ok {
+		t.Errorf("got unexpected type of message: %T", res)
+	}
+
+	res, err = command(2)
+	var causeErr *gtpv2.CauseNotOKError
+	if !errors.As(err, &causeErr) {
+		t.Fatalf("unexpected error. got: %v, want: %T", err, causeErr)
+	}
+	if causeErr.Cause != gtpv2.CauseServiceNotSupported {
+		t.Errorf("got Cause: %d, want: %d", causeErr.Cause, gtpv2.CauseServiceNotSupported)
+	}
+	if _, ok := res.(*message.BearerResourceFailureIndication); !ok {
+		t.Errorf("got unexpected type of message: %T", res)
+	}
+
+	select {
+	case msg := <-handledCh:
+		t.Fatalf("triggered request is passed to handler: %v", msg)
+	default:
+	}
+
+	shortCtx, shortCancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer shortCancel()
+	_, err = cliConn.BearerResourceCommand(
+		shortCtx, 0x11111111, sess,
+		ie.NewEPSBearerID(5),
+		ie.NewProcedureTransactionID(3),
+		ie.NewTrafficAggregateDescriptionNoTFTOperation(),
+	)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("unexpected error. got: %v, want: %v", err, context.DeadlineExceeded)
+	}
+	handled := map[uint8]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-handledCh:
+			handled[msg.MessageType()] = true
+		case <-time.After(time.Second):
+			t.Fatal("request not triggered by the command is not passed to handler")
+		}
+	}
+	if !handled[message.MsgTypeUpdateBearerRequest] || !handled[message.MsgTypeCreateBearerRequest] {
+		t.Errorf("unexpected requests passed to handler: %v", handled)
+	}
+
+	if _, err := cliConn.BearerResourceCommand(reqCtx, 0x11111111, sess, ie.NewEPSBearerID(5)); err == nil {
+		t.Error("Bearer Resource Command without PTI should fail")
+	}
+}
+
+func TestPiggybacked(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	conn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11MMEGTPC)
+
+	handledCh := make(chan uint8, 2)
+	conn.AddHandlers(map[uint8]gtpv2.HandlerFunc{
+		message.MsgTypeCreateSessionResponse: func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+			handledCh <- msg.MessageType()
+			return nil
+		},
+		message.MsgTypeCreateBearerRequest: func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+			handledCh <- msg.MessageType()
+			return c.RespondTo(
+				senderAddr, msg,
+				message.NewCreateBearerResponse(0, 0, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil)),
+			)
+		},
+	})
+
+	res := message.NewCreateSessionResponse(0, 1, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil))
+	res.SetPiggybacked(message.NewCreateBearerRequest(0, 2, ie.NewEPSBearerID(5)))
+	b, err := message.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := peer.WriteTo(b, conn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []uint8{message.MsgTypeCreateSessionResponse, message.MsgTypeCreateBearerRequest} {
+		select {
+		case got := <-handledCh:
+			if got != want {
+				t.Errorf("unexpected order of messages handled. got: %d, want: %d", got, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("message type %d is not handled", want)
+		}
+	}
+
+	buf := make([]byte, 1500)
+	if err := peer.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	n, _, err := peer.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("response not received: %s", err)
+	}
+	msg, err := message.Parse(buf[:n])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := msg.(*message.CreateBearerResponse); !ok {
+		t.Fatalf("got unexpected type of message: %T", msg)
+	}
+	if got, want := msg.Sequence(), uint32(2); got != want {
+		t.Errorf("invalid sequence number. got: %d, want: %d", got, want)
+	}
+}
+
+func TestPiggybackedRetransmission(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	conn := newLoopbackConn(ctx, t, gtpv2.IFTypeS5S8PGWGTPC)
+	conn.EnableReliableDelivery(100*time.Millisecond, 3)
+
+	rspCh := make(chan message.Message, 2)
+	conn.AddHandlers(map[uint8]gtpv2.HandlerFunc{
+		message.MsgTypeCreateSessionRequest: func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+			res := message.NewCreateSessionResponse(0, 0, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil))
+			res.SetPiggybacked(message.NewCreateBearerRequest(0, 0, ie.NewEPSBearerID(5)))
+			return c.RespondTo(senderAddr, msg, res)
+		},
+		message.MsgTypeCreateBearerResponse: func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+			rspCh <- msg
+			return nil
+		},
+	})
+
+	b, err := message.NewCreateSessionRequest(0, 1, ie.NewIMSI("123451234567890")).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := peer.WriteTo(b, conn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+
+	// the piggybacked request should be retransmitted together with the response
+	// until the triggered message comes.
+	buf := make([]byte, 1500)
+	var first []byte
+	for i := 0; i < 2; i++ {
+		if err := peer.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, _, err := peer.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("message not received: %s", err)
+		}
+		if first == nil {
+			first = append([]byte{}, buf[:n]...)
+			continue
+		}
+		if !bytes.Equal(first, buf[:n]) {
+			t.Errorf("retransmitted message differs. got: %x, want: %x", buf[:n], first)
+		}
+	}
+
+	msg, err := message.Parse(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := msg.Piggybacked()
+	if p == nil {
+		t.Fatal("piggybacked message not found")
+	}
+	b, err = message.NewCreateBearerResponse(
+		0, p.Sequence(), ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+	).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := peer.WriteTo(b, conn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-rspCh:
+	case <-time.After(time.Second):
+		t.Fatal("triggered message is not handled")
+	}
+
+	// the retransmission should stop, allowing one in flight.
+	if err := peer.SetReadDeadline(time.Now().Add(300 * time.Millisecond)); err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	for {
+		if _, _, err := peer.ReadFrom(buf); err != nil {
+			break
+		}
+		count++
+	}
+	if count > 1 {
+		t.Errorf("retransmission continued after the triggered message: %d", count)
+	}
+}
+
+func TestOverloadControl(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	cliConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11MMEGTPC)
+	cliConn.EnableOverloadControl()
+	cliConn.AddHandler(
+		message.MsgTypeCreateSessionResponse,
+		func(c *gtpv2.Conn, senderAddr net.Addr, msg message.Message) error {
+			return nil
+		},
+	)
+
+	peerAddr := peer.LocalAddr()
+	announce := func(seq uint32, reduction uint8, validity time.Duration, load uint8) {
+		t.Helper()
+
+		b, err := message.NewCreateSessionResponse(
+			0, 0,
+			ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+			// P-GW's one should be ignored.
+			ie.NewOverloadControlInformation(seq, 50, time.Minute),
+			ie.NewOverloadControlInformation(seq, reduction, validity).WithInstance(1),
+			ie.NewLoadControlInformation(seq, load).WithInstance(2),
+		).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := peer.WriteTo(b, cliConn.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+
+		deadline := time.Now().Add(time.Second)
+		for {
+			if got, _ := cliConn.PeerLoadMetric(peerAddr); got == load {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatal("timed out waiting for Load Control Information to be tracked")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	announce(math.MaxUint32, 100, time.Minute, 40)
+	if got, ok := cliConn.PeerOverloadMetric(peerAddr); !ok || got != 100 {
+		t.Errorf("unexpected Overload Reduction Metric. got: %d, want: 100", got)
+	}
+
+	seq, err := cliConn.SendMessageTo(message.NewDeleteSessionRequest(0, 0, ie.NewEPSBearerID(5)), peerAddr)
+	var olErr *gtpv2.PeerOverloadedError
+	if !errors.As(err, &olErr) {
+		t.Errorf("unexpected error. got: %v, want: %T", err, olErr)
+	}
+	if seq != 0 {
+		t.Errorf("unexpected Sequence Number of the discarded message: got %d, want 0", seq)
+	}
+	if _, err := cliConn.SendMessageTo(message.NewEchoRequest(0, ie.NewRecovery(0)), peerAddr); err != nil {
+		t.Errorf("Echo Request should not be throttled: %v", err)
+	}
+
+	cliConn.SetOverloadExemptPriority(1)
+	prioritized := message.NewDeleteSessionRequest(0, 0, ie.NewEPSBearerID(5))
+	prioritized.SetMessagePriority(1)
+	if _, err := cliConn.SendMessageTo(prioritized, peerAddr); err != nil {
+		t.Errorf("prioritized message should not be throttled: %v", err)
+	}
+
+	// the end of overload is announced with zero Period of Validity.
+	// the Sequence Number wraps around, which should be treated as newer.
+	announce(1, 100, 0, 100)
+	if got, _ := cliConn.PeerOverloadMetric(peerAddr); got != 0 {
+		t.Errorf("unexpected Overload Reduction Metric. got: %d, want: 0", got)
+	}
+	if _, err := cliConn.SendMessageTo(message.NewDeleteSessionRequest(0, 0, ie.NewEPSBearerID(5)), peerAddr); err != nil {
+		t.Errorf("message should not be throttled: %v", err)
+	}
+
+	other, err := net.ResolveUDPAddr("udp", "127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		selected, err := cliConn.SelectPeer(peerAddr, other)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if selected != other {
+			t.Errorf("fully loaded peer is selected: %s", selected)
+		}
+	}
+	if _, err := cliConn.SelectPeer(peerAddr); !errors.Is(err, gtpv2.ErrNoPeerAvailable) {
+		t.Errorf("unexpected error. got: %v, want: %v", err, gtpv2.ErrNoPeerAvailable)
+	}
+
+	// the information in the message that fails validation should be ignored.
+	b, err := message.NewCreateSessionResponse(
+		0xdeadbeef, 0,
+		ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+		ie.NewOverloadControlInformation(10, 100, time.Minute).WithInstance(1),
+		ie.NewLoadControlInformation(10, 20).WithInstance(2),
+	).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := peer.WriteTo(b, cliConn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+	announce(2, 50, time.Minute, 30)
+	if got, _ := cliConn.PeerOverloadMetric(peerAddr); got != 50 {
+		t.Errorf("unexpected Overload Reduction Metric. got: %d, want: 50", got)
+	}
+}
+
+func TestPathManagement(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	cliConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11MMEGTPC)
+
+	restartedCh := make(chan uint8, 1)
+	downCh := make(chan net.Addr, 1)
+	cliConn.SetPeerRestartedHandler(func(c *gtpv2.Conn, peer net.Addr, counter uint8) {
+		restartedCh <- counter
+	})
+	cliConn.SetPeerDownHandler(func(c *gtpv2.Conn, peer net.Addr) {
+		downCh <- peer
+	})
+	cliConn.AddPeer(peer.LocalAddr())
+	cliConn.EnablePathManagement(20*time.Millisecond, 3)
+
+	// respond to the Echo Requests with the Restart Counter given.
+	buf := make([]byte, 1500)
+	echo := func(counter uint8) {
+		t.Helper()
+
+		if err := peer.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		n, raddr, err := peer.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("Echo Request not received: %s", err)
+		}
+		req, err := message.Parse(buf[:n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := req.(*message.EchoRequest); !ok {
+			t.Fatalf("unexpected message: %v", req)
+		}
+
+		rsp, err := message.NewEchoResponse(req.Sequence(), ie.NewRecovery(counter)).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := peer.WriteTo(rsp, raddr); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	echo(0)
+	echo(0)
+	deadline := time.Now().Add(time.Second)
+	for {
+		if counter, ok := cliConn.PeerRestartCounter(peer.LocalAddr()); ok {
+			if counter != 0 {
+				t.Errorf("unexpected Restart Counter: got %d, want 0", counter)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for Echo Response to be handled")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case <-restartedCh:
+		t.Fatal("peer restart detected with the same Restart Counter")
+	default:
+	}
+
+	echo(1)
+	select {
+	case counter := <-restartedCh:
+		if counter != 1 {
+			t.Errorf("unexpected Restart Counter: got %d, want 1", counter)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for peer restart to be detected")
+	}
+
+	// stop responding.
+	select {
+	case addr := <-downCh:
+		if addr.String() != peer.LocalAddr().String() {
+			t.Errorf("unexpected peer down: %s", addr)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for path down to be detected")
+	}
+	if !cliConn.IsPeerDown(peer.LocalAddr()) {
+		t.Error("peer should be down")
+	}
+}
+
+func TestPathManagementStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	connCtx, connCancel := context.WithCancel(ctx)
+	cliConn := newLoopbackConn(connCtx, t, gtpv2.IFTypeS11MMEGTPC)
+
+	downCh := make(chan net.Addr, 1)
+	cliConn.SetPeerDownHandler(func(c *gtpv2.Conn, peer net.Addr) {
+		downCh <- peer
+	})
+	cliConn.AddPeer(peer.LocalAddr())
+	cliConn.EnablePathManagement(20*time.Millisecond, 3)
+
+	buf := make([]byte, 1500)
+	if err := peer.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := peer.ReadFrom(buf); err != nil {
+		t.Fatalf("Echo Request not received: %s", err)
+	}
+
+	// the Echo should stop with Serve, otherwise the path is considered down
+	// as the Echo Requests sent on the closed conn are never answered.
+	connCancel()
+	select {
+	case addr := <-downCh:
+		t.Fatalf("path management still running after ctx is canceled: %s is down", addr)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestRestartCounterOfInvalidMessage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peer := listenLoopback(t)
+	cliConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11MMEGTPC)
+
+	restartedCh := make(chan uint8, 2)
+	cliConn.SetPeerRestartedHandler(func(c *gtpv2.Conn, peer net.Addr, counter uint8) {
+		restartedCh <- counter
+	})
+
+	send := func(msg message.Message) {
+		t.Helper()
+
+		b, err := message.Marshal(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := peer.WriteTo(b, cliConn.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	echo := func(seq uint32, counter uint8) {
+		t.Helper()
+
+		send(message.NewEchoRequest(seq, ie.NewRecovery(counter)))
+		if err := peer.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := peer.ReadFrom(make([]byte, 1500)); err != nil {
+			t.Fatalf("Echo Response not received: %s", err)
+		}
+	}
+
+	echo(1, 0)
+	// the message with unknown TEID should not update the Restart Counter.
+	send(message.NewModifyBearerRequest(0xdeadbeef, 2, ie.NewRecovery(1)))
+	echo(3, 0)
+
+	select {
+	case counter := <-restartedCh:
+		t.Fatalf("peer restart detected with the invalid message: %d", counter)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSessionCleanup(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pgw := listenLoopback(t)
+	mme := listenLoopback(t)
+	s5cConn := newLoopbackConn(ctx, t, gtpv2.IFTypeS5S8SGWGTPC)
+	s11Conn := newLoopbackConn(ctx, t, gtpv2.IFTypeS11S4SGWGTPC)
+
+	const imsi = "123451234567890"
+	s5cConn.RegisterSession(0x11111111, gtpv2.NewSession(pgw.LocalAddr(), &gtpv2.Subscriber{IMSI: imsi}))
+	s11Conn.RegisterSession(0x22222222, gtpv2.NewSession(mme.LocalAddr(), &gtpv2.Subscriber{IMSI: imsi}))
+
+	removedCh := make(chan *gtpv2.Session, 1)
+	s5cConn.EnableSessionCleanup(func(c *gtpv2.Conn, sess *gtpv2.Session) {
+		removedCh <- sess
+	})
+	if err := s5cConn.EnablePGWRestartNotification(s11Conn, "0.0.0.0"); err == nil {
+		t.Error("unspecified S-GW IP should be rejected")
+	}
+	if err := s5cConn.EnablePGWRestartNotification(s11Conn, "127.0.0.2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the P-GW sends Echo Request with the Restart Counter incremented.
+	for _, counter := range []uint8{0, 1} {
+		req, err := message.NewEchoRequest(uint32(counter+1), ie.NewRecovery(counter)).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := pgw.WriteTo(req, s5cConn.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		// wait for the Echo Response so that the requests are handled in order.
+		if err := pgw.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := pgw.ReadFrom(make([]byte, 1500)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	select {
+	case sess := <-removedCh:
+		if sess.IMSI != imsi {
+			t.Errorf("unexpected Session removed: %s", sess.IMSI)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the Session to be removed")
+	}
+	if _, err := s5cConn.GetSessionByIMSI(imsi); err == nil {
+		t.Error("Session with the restarted peer should be removed")
+	}
+
+	buf := make([]byte, 1500)
+	if err := mme.SetReadDeadline(time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	n, _, err := mme.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("PGW Restart Notification not received: %s", err)
+	}
+	msg, err := message.Parse(buf[:n])
+	if err != nil {
+		t.Fatal(err)
+	}
+	ntf, ok := msg.(*message.PGWRestartNotification)
+	if !ok {
+		t.Fatalf("unexpected message: %v", msg)
+	}
+	if got := ntf.PGWS5S8IPAddressForControlPlaneOrPMIP.MustIPAddress(); got != "127.0.0.1" {
+		t.Errorf("unexpected P-GW IP: %s", got)
+	}
+	if got := ntf.SGWS11S4IPAddressForControlPlane.MustIPAddress(); got != "127.0.0.2" {
+		t.Errorf("unexpected S-GW IP: %s", got)
+	}
+}
@@ -310,3 +310,138 @@ const (
 	DaylightSavingPlusOneHour
 	DaylightSavingPlusTwoHours
 )
+
+// Security Mode definitions.
+const (
+	SecurityModeGSMKeyAndTriplets uint8 = iota
+	SecurityModeUMTSKeyUsedCipherAndQuintuplets
+	SecurityModeGSMKeyUsedCipherAndQuintuplets
+	SecurityModeUMTSKeyAndQuintuplets
+	SecurityModeEPSSecurityContextAndQuadruplets
+	SecurityModeUMTSKeyQuadrupletsAndQuintuplets
+)
+
+// F-Container Type definitions.
+const (
+	_ uint8 = iota
+	FContainerTypeUTRANTransparentContainer
+	FContainerTypeBSSContainer
+	FContainerTypeEUTRANTransparentContainer
+	FContainerTypeNBIFOMContainer
+	FContainerTypeENDCContainer
+	FContainerTypeInterSystemSONContainer
+)
+
+// Target Type definitions.
+const (
+	TargetTypeRNCID uint8 = iota
+	TargetTypeMacroENodeBID
+	TargetTypeCellIdentifier
+	TargetTypeHomeENodeBID
+	TargetTypeExtendedMacroENodeBID
+	TargetTypeGNodeBID
+	TargetTypeMacroNGENodeBID
+	TargetTypeExtendedNGENodeBID
+)
+
+// Source Type definitions.
+const (
+	SourceTypeCellID uint8 = iota
+	SourceTypeRNCID
+)
+
+// Session Trace Depth definitions.
+const (
+	TraceDepthMinimum uint8 = iota
+	TraceDepthMedium
+	TraceDepthMaximum
+	TraceDepthMinimumWithoutVendorSpecificExtension
+	TraceDepthMediumWithoutVendorSpecificExtension
+	TraceDepthMaximumWithoutVendorSpecificExtension
+)
+
+// MBMS Distribution Indication definitions.
+const (
+	MBMSDistributionNoRNCsAccepted uint8 = iota
+	MBMSDistributionAllRNCsAccepted
+	MBMSDistributionSomeRNCsAccepted
+)
+
+// MBMS HC Indicator definitions.
+const (
+	MBMSHCIndicatorUncompressedHeader uint8 = iota
+	MBMSHCIndicatorCompressedHeader
+)
+
+// SRVCC Cause definitions.
+const (
+	_ uint8 = iota
+	SRVCCCauseUnspecified
+	SRVCCCauseHandoverRelocationCancelledBySourceSystem
+	SRVCCCauseHandoverRelocationFailureWithTargetSystem
+	SRVCCCauseHandoverRelocationTargetNotAllowed
+	SRVCCCauseUnknownTargetID
+	SRVCCCauseTargetCellNotAvailable
+	SRVCCCauseNoRadioResourcesAvailableInTargetCell
+	SRVCCCauseFailureInRadioInterfaceProcedure
+	SRVCCCausePermanentSessionLegEstablishmentError
+	SRVCCCauseTemporarySessionLegEstablishmentError
+)
+
+// Action Indication definitions.
+const (
+	ActionIndicationNoAction uint8 = iota
+	ActionIndicationDeactivationIndication
+	ActionIndicationPagingIndication
+	ActionIndicationPagingStopIndication
+)
+
+// Secondary RAT Type definitions.
+const (
+	SecondaryRATTypeNR uint8 = iota
+	SecondaryRATTypeUnlicensedSpectrum
+)
+
+// Change Reporting Action definitions.
+const (
+	ChangeReportingActionStopReporting uint8 = iota
+	ChangeReportingActionStartReportingCGISAI
+	ChangeReportingActionStartReportingRAI
+	ChangeReportingActionStartReportingTAI
+	ChangeReportingActionStartReportingECGI
+	ChangeReportingActionStartReportingCGISAIAndRAI
+	ChangeReportingActionStartReportingTAIAndECGI
+	ChangeReportingActionStartReportingMacroENBIDAndExtendedMacroENBID
+	ChangeReportingActionStartReportingTAIMacroENBIDAndExtendedMacroENBID
+)
+
+// Presence Reporting Area Action definitions.
+const (
+	_ uint8 = iota
+	PresenceReportingAreaActionStartReporting
+	PresenceReportingAreaActionStopReporting
+	PresenceReportingAreaActionModifyPresenceReportingAreaElements
+)
+
+// Relay Identity Type definitions.
+const (
+	RelayIdentityTypeIPAddress uint8 = iota
+	RelayIdentityTypeFQDN
+)
+
+// ROHC Profiles definitions.
+const (
+	ROHCProfileRTPUDPIP   uint8 = 0x01 // 0x0002
+	ROHCProfileUDPIP      uint8 = 0x02 // 0x0003
+	ROHCProfileESPIP      uint8 = 0x04 // 0x0004
+	ROHCProfileTCPIP      uint8 = 0x08 // 0x0006
+	ROHCProfileRTPUDPIPv2 uint8 = 0x10 // 0x0102
+	ROHCProfileUDPIPv2    uint8 = 0x20 // 0x0103
+	ROHCProfileESPIPv2    uint8 = 0x40 // 0x0104
+)
+
+// Services Authorized definitions.
+const (
+	ServicesAuthorizedAuthorized uint8 = iota
+	ServicesAuthorizedNotAuthorized
+)
@@ -7,6 +7,7 @@ package gtpv2
 import (
 	"errors"
 	"fmt"
+	"net"
 
 	"github.com/wmnsk/go-gtp/gtpv2/message"
 )
@@ -18,6 +19,9 @@ var (
 	// ErrTimeout indicates that a handler failed to complete its work due to the
 	// absence of message expected to come from another endpoint.
 	ErrTimeout = errors.New("timed out")
+
+	// ErrNoPeerAvailable indicates that all the peers given are fully loaded or overloaded.
+	ErrNoPeerAvailable = errors.New("no peer available")
 )
 
 // CauseNotOKError indicates that the value in Cause IE is not OK.
@@ -156,3 +160,35 @@ type HandlerNotFoundError struct {
 func (e *HandlerNotFoundError) Error() string {
 	return fmt.Sprintf("no handlers found for incoming message: %s, ignoring", e.MsgType)
 }
+
+// PeerNotRespondingError indicates that no response is received for the initial message
+// even after it is retransmitted N3 times at intervals of T3.
+type PeerNotRespondingError struct {
+	Peer    net.Addr
+	Msg     message.Message
+	Retries int
+}
+
+// Error returns the message not responded and the peer.
+func (e *PeerNotRespondingError) Error() string {
+	return fmt.Sprintf(
+		"peer %s not responding to %s (seq: %d) after %d retransmissions",
+		e.Peer, e.Msg.MessageTypeName(), e.Msg.Sequence(), e.Retries,
+	)
+}
+
+// PeerOverloadedError indicates that the initial message is discarded by the overload
+// control, as the peer has requested to reduce the traffic.
+type PeerOverloadedError struct {
+	Peer   net.Addr
+	Msg    message.Message
+	Metric uint8
+}
+
+// Error returns the message discarded and the peer.
+func (e *PeerOverloadedError) Error() string {
+	return fmt.Sprintf(
+		"%s to %s is discarded, as the peer is overloaded (reduction metric: %d)",
+		e.Msg.MessageTypeName(), e.Peer, e.Metric,
+	)
+}
@@ -0,0 +1,151 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"net"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+// fwdInstances maps the instance of F-TEID for DL data forwarding in Bearer Context
+// within Create Indirect Data Forwarding Tunnel Request to the instance of F-TEID
+// allocated by SGW in the one within the Response.
+//
+// TS 29.274 Table 7.2.18-2 and 7.2.19-2
+var fwdInstances = map[uint8]uint8{
+	0: 0, // eNodeB F-TEID => S1-U SGW F-TEID
+	1: 0, // SGW/UPF F-TEID => S1-U SGW F-TEID
+	2: 2, // SGSN F-TEID => S4-U SGW F-TEID
+	3: 1, // RNC F-TEID => S12 SGW F-TEID
+}
+
+// CreateIndirectForwardingTunnels sets up the indirect data forwarding tunnels
+// requested by Create Indirect Data Forwarding Tunnel Request on uConn.
+//
+// For each F-TEID for DL data forwarding in the Bearer Contexts, it allocates a new
+// F-TEID with v4 and/or v6 as the address of uConn, and relays the T-PDUs arriving
+// at that F-TEID to the F-TEID in the request. The returned Bearer Contexts contain
+// the allocated F-TEIDs and are ready to be set in the Response.
+//
+// The tunnels are kept in the Session until DeleteIndirectForwardingTunnels is called.
+// This cannot be used with Kernel GTP-U, as it relies on (*UPlaneConn).RelayTo.
+func (s *Session) CreateIndirectForwardingTunnels(uConn *gtpv1.UPlaneConn, v4, v6 string, req *message.CreateIndirectDataForwardingTunnelRequest) ([]*ie.IE, error) {
+	if len(req.BearerContexts) == 0 {
+		return nil, &RequiredIEMissingError{Type: ie.BearerContext}
+	}
+
+	var (
+		brCtxs    []*ie.IE
+		allocated []uint32
+	)
+	for _, brCtx := range req.BearerContexts {
+		var (
+			ebi    *ie.IE
+			fteids []*ie.IE
+		)
+		for _, child := range brCtx.ChildIEs {
+			switch child.Type {
+			case ie.EPSBearerID:
+				ebi = child
+			case ie.FullyQualifiedTEID:
+				inst, ok := fwdInstances[child.Instance()]
+				if !ok {
+					continue
+				}
+
+				fteid, err := s.relayForwardedTPDU(uConn, v4, v6, child)
+				if err != nil {
+					s.closeRelays(uConn, allocated)
+					return nil, err
+				}
+				allocated = append(allocated, fteid.MustTEID())
+				fteids = append(fteids, fteid.WithInstance(inst))
+			}
+		}
+		if ebi == nil {
+			s.closeRelays(uConn, allocated)
+			return nil, &RequiredIEMissingError{Type: ie.EPSBearerID}
+		}
+
+		brCtxs = append(brCtxs, ie.NewBearerContextWithinCreateIndirectDataForwardingTunnelResponse(
+			ebi, ie.NewCause(CauseRequestAccepted, 0, 0, 0, nil), fteids...,
+		))
+	}
+
+	s.mu.Lock()
+	s.fwdTEIDs = append(s.fwdTEIDs, allocated...)
+	s.mu.Unlock()
+
+	return brCtxs, nil
+}
+
+// DeleteIndirectForwardingTunnels removes all the indirect data forwarding tunnels
+// set up by CreateIndirectForwardingTunnels from uConn, and releases the TEIDs.
+func (s *Session) DeleteIndirectForwardingTunnels(uConn *gtpv1.UPlaneConn) error {
+	s.mu.Lock()
+	teids := s.fwdTEIDs
+	s.fwdTEIDs = nil
+	s.mu.Unlock()
+
+	return s.closeRelays(uConn, teids)
+}
+
+// IndirectForwardingTEIDs returns the TEIDs allocated for the indirect data forwarding
+// tunnels of the Session.
+func (s *Session) IndirectForwardingTEIDs() []uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	teids := make([]uint32, len(s.fwdTEIDs))
+	copy(teids, s.fwdTEIDs)
+	return teids
+}
+
+// relayForwardedTPDU allocates a new F-TEID on uConn and relays the T-PDUs to the
+// destination given as peerFTEID.
+func (s *Session) relayForwardedTPDU(uConn *gtpv1.UPlaneConn, v4, v6 string, peerFTEID *ie.IE) (*ie.IE, error) {
+	teidOut, err := peerFTEID.TEID()
+	if err != nil {
+		return nil, err
+	}
+
+	var ip net.IP
+	if peerFTEID.HasIPv4() {
+		ip, err = peerFTEID.IPv4()
+	} else {
+		ip, err = peerFTEID.IPv6()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fteid := uConn.NewFTEID(IFTypeSGWUPFGTPUForDL, v4, v6)
+	if fteid == nil {
+		return nil, &RequiredParameterMissingError{"TEID", "failed to allocate TEID for data forwarding"}
+	}
+	teidIn := fteid.MustTEID()
+
+	raddr := &net.UDPAddr{IP: ip, Port: 2152}
+	if err := uConn.RelayTo(uConn, teidIn, teidOut, raddr); err != nil {
+		_ = uConn.CloseRelay(teidIn)
+		return nil, err
+	}
+
+	return fteid, nil
+}
+
+// closeRelays stops relaying the T-PDUs with the TEIDs given and releases them.
+func (s *Session) closeRelays(uConn *gtpv1.UPlaneConn, teids []uint32) error {
+	var lastErr error
+	for _, teid := range teids {
+		if err := uConn.CloseRelay(teid); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
@@ -0,0 +1,122 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+func listenUPlane(ctx context.Context, t *testing.T, addr string) *gtpv1.UPlaneConn {
+	t.Helper()
+
+	laddr, err := net.ResolveUDPAddr("udp", addr+gtpv1.GTPUPort)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uConn := gtpv1.NewUPlaneConn(laddr)
+	uConn.DisableErrorIndication()
+	if err := uConn.Listen(ctx); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := uConn.Serve(ctx); err != nil {
+			return
+		}
+	}()
+	return uConn
+}
+
+func TestIndirectForwardingTunnels(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sgwConn := listenUPlane(ctx, t, "127.0.0.21")
+	targetConn := listenUPlane(ctx, t, "127.0.0.22")
+	sourceConn := listenUPlane(ctx, t, "127.0.0.23")
+
+	sess := gtpv2.NewSession(sgwConn.LocalAddr(), &gtpv2.Subscriber{IMSI: "123451234567890"})
+	req := message.NewCreateIndirectDataForwardingTunnelRequest(
+		0x11111111, 0,
+		ie.NewBearerContextWithinCreateIndirectDataForwardingTunnelRequest(
+			ie.NewEPSBearerID(5),
+			ie.NewFullyQualifiedTEID(gtpv2.IFTypeeNodeBGTPUForDL, 0x22222222, "127.0.0.22", ""),
+		),
+	)
+
+	brCtxs, err := sess.CreateIndirectForwardingTunnels(sgwConn, "127.0.0.21", "", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(brCtxs) != 1 {
+		t.Fatalf("got %d Bearer Contexts, want 1", len(brCtxs))
+	}
+
+	var teidIn uint32
+	for _, child := range brCtxs[0].ChildIEs {
+		if child.Type != ie.FullyQualifiedTEID {
+			continue
+		}
+		if got := child.MustInterfaceType(); got != gtpv2.IFTypeSGWUPFGTPUForDL {
+			t.Errorf("got interface type %d, want %d", got, gtpv2.IFTypeSGWUPFGTPUForDL)
+		}
+		if got := child.MustIPv4().String(); got != "127.0.0.21" {
+			t.Errorf("got IP %s, want 127.0.0.21", got)
+		}
+		teidIn = child.MustTEID()
+	}
+	if teidIn == 0 {
+		t.Fatal("F-TEID for DL data forwarding not found")
+	}
+	if teids := sess.IndirectForwardingTEIDs(); len(teids) != 1 || teids[0] != teidIn {
+		t.Errorf("got TEIDs %v, want [%#x]", teids, teidIn)
+	}
+
+	payload := []byte{0xde, 0xad, 0xbe, 0xef}
+	if _, err := sourceConn.WriteToGTP(teidIn, payload, sgwConn.LocalAddr()); err != nil {
+		t.Fatal(err)
+	}
+
+	type tpdu struct {
+		teid    uint32
+		payload []byte
+	}
+	tpduCh := make(chan tpdu)
+	go func() {
+		buf := make([]byte, 1500)
+		n, _, teid, err := targetConn.ReadFromGTP(buf)
+		if err != nil {
+			return
+		}
+		tpduCh <- tpdu{teid, buf[:n]}
+	}()
+
+	select {
+	case got := <-tpduCh:
+		if got.teid != 0x22222222 {
+			t.Errorf("got TEID %#x, want %#x", got.teid, 0x22222222)
+		}
+		if string(got.payload) != string(payload) {
+			t.Errorf("got payload %x, want %x", got.payload, payload)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out while waiting for T-PDU to be forwarded")
+	}
+
+	if err := sess.DeleteIndirectForwardingTunnels(sgwConn); err != nil {
+		t.Fatal(err)
+	}
+	if teids := sess.IndirectForwardingTEIDs(); len(teids) != 0 {
+		t.Errorf("got TEIDs %v after deletion, want none", teids)
+	}
+}
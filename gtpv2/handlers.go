@@ -15,6 +15,23 @@ import (
 // HandlerFunc is a handler for specific GTPv2-C message.
 type HandlerFunc func(c *Conn, senderAddr net.Addr, msg message.Message) error
 
+// NotRespondingHandlerFunc is a handler called when the peer does not respond to
+// the initial message sent with reliable delivery enabled.
+type NotRespondingHandlerFunc func(c *Conn, err *PeerNotRespondingError)
+
+// PeerDownHandlerFunc is a handler called when the path to the peer is considered
+// down, as the peer did not respond to the Echo Requests.
+type PeerDownHandlerFunc func(c *Conn, peer net.Addr)
+
+// PeerRestartedHandlerFunc is a handler called when the peer is found to be restarted,
+// as the Restart Counter in Recovery IE received from the peer has changed.
+// counter is the new Restart Counter value of the peer.
+type PeerRestartedHandlerFunc func(c *Conn, peer net.Addr, counter uint8)
+
+// SessionCleanupHandlerFunc is a handler called for each Session removed from Conn
+// on the restart of the peer.
+type SessionCleanupHandlerFunc func(c *Conn, sess *Session)
+
 type msgHandlerMap struct {
 	syncMap sync.Map
 }
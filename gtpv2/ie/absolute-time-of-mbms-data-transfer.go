@@ -0,0 +1,45 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// NewAbsoluteTimeofMBMSDataTransfer creates a new AbsoluteTimeofMBMSDataTransfer IE.
+//
+// The time is encoded in the NTP timestamp format.
+func NewAbsoluteTimeofMBMSDataTransfer(ts time.Time) *IE {
+	frac := (uint64(ts.Nanosecond()) << 32) / uint64(time.Second)
+
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint32(b[0:4], timeToNTPSeconds(ts))
+	binary.BigEndian.PutUint32(b[4:8], uint32(frac))
+	return New(AbsoluteTimeofMBMSDataTransfer, 0x00, b)
+}
+
+// AbsoluteTimeofMBMSDataTransfer returns AbsoluteTimeofMBMSDataTransfer in time.Time
+// if the type of IE matches.
+func (i *IE) AbsoluteTimeofMBMSDataTransfer() (time.Time, error) {
+	if i.Type != AbsoluteTimeofMBMSDataTransfer {
+		return time.Time{}, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 8 {
+		return time.Time{}, io.ErrUnexpectedEOF
+	}
+
+	nsecs := (uint64(binary.BigEndian.Uint32(i.Payload[4:8])) * uint64(time.Second)) >> 32
+	return ntpSecondsToTime(binary.BigEndian.Uint32(i.Payload[0:4])).Add(time.Duration(nsecs)), nil
+}
+
+// MustAbsoluteTimeofMBMSDataTransfer returns AbsoluteTimeofMBMSDataTransfer in time.Time,
+// ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustAbsoluteTimeofMBMSDataTransfer() time.Time {
+	v, _ := i.AbsoluteTimeofMBMSDataTransfer()
+	return v
+}
@@ -0,0 +1,31 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewActionIndication creates a new ActionIndication IE.
+func NewActionIndication(ind uint8) *IE {
+	return newUint8ValIE(ActionIndication, ind&0x07)
+}
+
+// ActionIndication returns ActionIndication in uint8 if the type of IE matches.
+func (i *IE) ActionIndication() (uint8, error) {
+	if i.Type != ActionIndication {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0] & 0x07, nil
+}
+
+// MustActionIndication returns ActionIndication in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustActionIndication() uint8 {
+	v, _ := i.ActionIndication()
+	return v
+}
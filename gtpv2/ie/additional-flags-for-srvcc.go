@@ -0,0 +1,63 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewAdditionalFlagsForSRVCC creates a new AdditionalFlagsForSRVCC IE.
+func NewAdditionalFlagsForSRVCC(vf, ics uint8) *IE {
+	i := New(AdditionalFlagsForSRVCC, 0x00, make([]byte, 1))
+	i.Payload[0] |= (vf << 1 & 0x02) | (ics & 0x01)
+	return i
+}
+
+// AdditionalFlagsForSRVCC returns AdditionalFlagsForSRVCC in uint8 if the type of IE matches.
+func (i *IE) AdditionalFlagsForSRVCC() (uint8, error) {
+	if i.Type != AdditionalFlagsForSRVCC {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustAdditionalFlagsForSRVCC returns AdditionalFlagsForSRVCC in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustAdditionalFlagsForSRVCC() uint8 {
+	v, _ := i.AdditionalFlagsForSRVCC()
+	return v
+}
+
+// HasICS reports whether an IE has ICS bit.
+func (i *IE) HasICS() bool {
+	switch i.Type {
+	case AdditionalFlagsForSRVCC:
+		v, err := i.AdditionalFlagsForSRVCC()
+		if err != nil {
+			return false
+		}
+		return has1stBit(v)
+	case SvFlags:
+		v, err := i.SvFlags()
+		if err != nil {
+			return false
+		}
+		return has2ndBit(v)
+	default:
+		return false
+	}
+}
+
+// HasVF reports whether an IE has VF bit.
+func (i *IE) HasVF() bool {
+	v, err := i.AdditionalFlagsForSRVCC()
+	if err != nil {
+		return false
+	}
+
+	return has2ndBit(v)
+}
@@ -0,0 +1,139 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewAdditionalMMContextForSRVCC creates a new AdditionalMMContextForSRVCC IE.
+//
+// Each of msClassmark2, msClassmark3 and codecs should be shorter than 256 bytes,
+// and can be nil if it is not available.
+func NewAdditionalMMContextForSRVCC(msClassmark2, msClassmark3, codecs []byte) *IE {
+	v := NewAdditionalMMContextForSRVCCFields(msClassmark2, msClassmark3, codecs)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(AdditionalMMContextForSRVCC, 0x00, b)
+}
+
+// AdditionalMMContextForSRVCC returns AdditionalMMContextForSRVCC in
+// AdditionalMMContextForSRVCCFields type if the type of IE matches.
+func (i *IE) AdditionalMMContextForSRVCC() (*AdditionalMMContextForSRVCCFields, error) {
+	switch i.Type {
+	case AdditionalMMContextForSRVCC:
+		return ParseAdditionalMMContextForSRVCCFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// AdditionalMMContextForSRVCCFields is a set of fields in AdditionalMMContextForSRVCC IE.
+type AdditionalMMContextForSRVCCFields struct {
+	MSClassmark2       []byte
+	MSClassmark3       []byte
+	SupportedCodecList []byte
+}
+
+// NewAdditionalMMContextForSRVCCFields creates a new AdditionalMMContextForSRVCCFields.
+func NewAdditionalMMContextForSRVCCFields(msClassmark2, msClassmark3, codecs []byte) *AdditionalMMContextForSRVCCFields {
+	return &AdditionalMMContextForSRVCCFields{
+		MSClassmark2:       msClassmark2,
+		MSClassmark3:       msClassmark3,
+		SupportedCodecList: codecs,
+	}
+}
+
+// Marshal serializes AdditionalMMContextForSRVCCFields.
+func (f *AdditionalMMContextForSRVCCFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes AdditionalMMContextForSRVCCFields.
+func (f *AdditionalMMContextForSRVCCFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	_, err := marshalSRVCCClassmarks(b, f.MSClassmark2, f.MSClassmark3, f.SupportedCodecList)
+	return err
+}
+
+// ParseAdditionalMMContextForSRVCCFields decodes AdditionalMMContextForSRVCCFields.
+func ParseAdditionalMMContextForSRVCCFields(b []byte) (*AdditionalMMContextForSRVCCFields, error) {
+	f := &AdditionalMMContextForSRVCCFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into AdditionalMMContextForSRVCCFields.
+func (f *AdditionalMMContextForSRVCCFields) UnmarshalBinary(b []byte) error {
+	var err error
+	f.MSClassmark2, f.MSClassmark3, f.SupportedCodecList, _, err = parseSRVCCClassmarks(b)
+	return err
+}
+
+// MarshalLen returns the serial length of AdditionalMMContextForSRVCCFields in int.
+func (f *AdditionalMMContextForSRVCCFields) MarshalLen() int {
+	return 3 + len(f.MSClassmark2) + len(f.MSClassmark3) + len(f.SupportedCodecList)
+}
+
+// marshalSRVCCClassmarks serializes the MS Classmark 2, MS Classmark 3 and
+// Supported Codec List, each of which is preceded by its length in one octet.
+//
+// This is the common format used in AdditionalMMContextForSRVCC IE and the
+// MM Context IEs on Sv interface. It returns the number of bytes written.
+func marshalSRVCCClassmarks(b, cm2, cm3, codecs []byte) (int, error) {
+	offset := 0
+	for _, v := range [][]byte{cm2, cm3, codecs} {
+		if len(v) > 0xff {
+			return 0, ErrMalformed
+		}
+		if len(b) < offset+1+len(v) {
+			return 0, io.ErrUnexpectedEOF
+		}
+
+		b[offset] = uint8(len(v))
+		offset++
+		copy(b[offset:], v)
+		offset += len(v)
+	}
+
+	return offset, nil
+}
+
+// parseSRVCCClassmarks decodes the MS Classmark 2, MS Classmark 3 and
+// Supported Codec List serialized by marshalSRVCCClassmarks.
+// It returns the number of bytes read.
+func parseSRVCCClassmarks(b []byte) (cm2, cm3, codecs []byte, n int, err error) {
+	var vals [3][]byte
+	offset := 0
+	for idx := range vals {
+		if len(b) <= offset {
+			return nil, nil, nil, 0, io.ErrUnexpectedEOF
+		}
+
+		l := int(b[offset])
+		offset++
+		if len(b) < offset+l {
+			return nil, nil, nil, 0, io.ErrUnexpectedEOF
+		}
+		if l > 0 {
+			vals[idx] = b[offset : offset+l]
+		}
+		offset += l
+	}
+
+	return vals[0], vals[1], vals[2], offset, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// NewAPNRateControlStatus creates a new APNRateControlStatus IE.
+//
+// The validity time is encoded in seconds, and the fraction part is always zero.
+func NewAPNRateControlStatus(ulPackets, exceptionReports, dlPackets uint32, validity time.Time) *IE {
+	fields := NewAPNRateControlStatusFields(ulPackets, exceptionReports, dlPackets, validity)
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(APNRateControlStatus, 0x00, b)
+}
+
+// APNRateControlStatus returns APNRateControlStatus in APNRateControlStatusFields type
+// if the type of IE matches.
+func (i *IE) APNRateControlStatus() (*APNRateControlStatusFields, error) {
+	switch i.Type {
+	case APNRateControlStatus:
+		return ParseAPNRateControlStatusFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// APNRateControlStatusFields is a set of fields in APNRateControlStatus IE.
+type APNRateControlStatusFields struct {
+	UplinkPacketsAllowed               uint32
+	NumberOfAdditionalExceptionReports uint32
+	DownlinkPacketsAllowed             uint32
+	ValidityTime                       time.Time
+}
+
+// NewAPNRateControlStatusFields creates a new APNRateControlStatusFields.
+func NewAPNRateControlStatusFields(ulPackets, exceptionReports, dlPackets uint32, validity time.Time) *APNRateControlStatusFields {
+	return &APNRateControlStatusFields{
+		UplinkPacketsAllowed:               ulPackets,
+		NumberOfAdditionalExceptionReports: exceptionReports,
+		DownlinkPacketsAllowed:             dlPackets,
+		ValidityTime:                       validity,
+	}
+}
+
+// Marshal serializes APNRateControlStatusFields.
+func (f *APNRateControlStatusFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes APNRateControlStatusFields.
+func (f *APNRateControlStatusFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	binary.BigEndian.PutUint32(b[0:4], f.UplinkPacketsAllowed)
+	binary.BigEndian.PutUint32(b[4:8], f.NumberOfAdditionalExceptionReports)
+	binary.BigEndian.PutUint32(b[8:12], f.DownlinkPacketsAllowed)
+	binary.BigEndian.PutUint32(b[12:16], timeToNTPSeconds(f.ValidityTime))
+	binary.BigEndian.PutUint32(b[16:20], 0)
+
+	return nil
+}
+
+// ParseAPNRateControlStatusFields decodes APNRateControlStatusFields.
+func ParseAPNRateControlStatusFields(b []byte) (*APNRateControlStatusFields, error) {
+	f := &APNRateControlStatusFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into APNRateControlStatusFields.
+func (f *APNRateControlStatusFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 20 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.UplinkPacketsAllowed = binary.BigEndian.Uint32(b[0:4])
+	f.NumberOfAdditionalExceptionReports = binary.BigEndian.Uint32(b[4:8])
+	f.DownlinkPacketsAllowed = binary.BigEndian.Uint32(b[8:12])
+	// the fraction part is ignored.
+	f.ValidityTime = ntpSecondsToTime(binary.BigEndian.Uint32(b[12:16]))
+
+	return nil
+}
+
+// MarshalLen returns the serial length of APNRateControlStatusFields in int.
+func (f *APNRateControlStatusFields) MarshalLen() int {
+	return 20
+}
@@ -0,0 +1,40 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewAPNAndRelativeCapacity creates a new APNAndRelativeCapacity IE.
+//
+// The relative capacity is in the range of 1 to 100.
+func NewAPNAndRelativeCapacity(capacity uint8, apn string) *IE {
+	a := NewAccessPointName(apn)
+
+	i := New(APNAndRelativeCapacity, 0x00, make([]byte, 2+len(a.Payload)))
+	i.Payload[0] = capacity & 0x7f
+	i.Payload[1] = uint8(len(a.Payload))
+	copy(i.Payload[2:], a.Payload)
+
+	return i
+}
+
+// RelativeCapacity returns RelativeCapacity in uint8 if the type of IE matches.
+func (i *IE) RelativeCapacity() (uint8, error) {
+	if i.Type != APNAndRelativeCapacity {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0] & 0x7f, nil
+}
+
+// MustRelativeCapacity returns RelativeCapacity in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustRelativeCapacity() uint8 {
+	v, _ := i.RelativeCapacity()
+	return v
+}
@@ -5,6 +5,7 @@
 package ie
 
 import (
+	"io"
 	"strings"
 )
 
@@ -24,28 +25,42 @@ func NewAccessPointName(apn string) *IE {
 
 // AccessPointName returns AccessPointName in string if the type of IE matches.
 func (i *IE) AccessPointName() (string, error) {
-	if i.Type != AccessPointName {
+	switch i.Type {
+	case AccessPointName:
+		return decodeAPN(i.Payload), nil
+	case APNAndRelativeCapacity:
+		if len(i.Payload) < 2 {
+			return "", io.ErrUnexpectedEOF
+		}
+		l := int(i.Payload[1])
+		if len(i.Payload) < 2+l {
+			return "", io.ErrUnexpectedEOF
+		}
+		return decodeAPN(i.Payload[2 : 2+l]), nil
+	default:
 		return "", &InvalidTypeError{Type: i.Type}
 	}
+}
 
+func decodeAPN(b []byte) string {
 	var (
 		apn    []string
 		offset int
 	)
-	max := len(i.Payload)
+	max := len(b)
 	for {
 		if offset >= max {
 			break
 		}
-		l := int(i.Payload[offset])
+		l := int(b[offset])
 		if offset+l+1 > max {
 			break
 		}
-		apn = append(apn, string(i.Payload[offset+1:offset+l+1]))
+		apn = append(apn, string(b[offset+1:offset+l+1]))
 		offset += l + 1
 	}
 
-	return strings.Join(apn, "."), nil
+	return strings.Join(apn, ".")
 }
 
 // MustAccessPointName returns AccessPointName in string, ignoring errors.
@@ -0,0 +1,36 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewBitRate creates a new BitRate IE.
+//
+// The bit rate is in kbps. Use WithInstance(1) to put it as NR UE PC5 AMBR in V2XContext.
+func NewBitRate(kbps uint32) *IE {
+	return newUint32ValIE(BitRate, kbps)
+}
+
+// BitRate returns BitRate in uint32 if the type of IE matches.
+func (i *IE) BitRate() (uint32, error) {
+	if i.Type != BitRate {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return binary.BigEndian.Uint32(i.Payload[0:4]), nil
+}
+
+// MustBitRate returns BitRate in uint32, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustBitRate() uint32 {
+	v, _ := i.BitRate()
+	return v
+}
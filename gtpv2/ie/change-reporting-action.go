@@ -0,0 +1,31 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewChangeReportingAction creates a new ChangeReportingAction IE.
+func NewChangeReportingAction(action uint8) *IE {
+	return newUint8ValIE(ChangeReportingAction, action)
+}
+
+// ChangeReportingAction returns ChangeReportingAction in uint8 if the type of IE matches.
+func (i *IE) ChangeReportingAction() (uint8, error) {
+	if i.Type != ChangeReportingAction {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustChangeReportingAction returns ChangeReportingAction in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustChangeReportingAction() uint8 {
+	v, _ := i.ChangeReportingAction()
+	return v
+}
@@ -0,0 +1,34 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewChannelNeeded creates a new ChannelNeeded IE.
+//
+// The value should be the Channel Needed defined in TS 44.018, which has
+// the first channel in bits 1-2 and the second channel in bits 3-4.
+func NewChannelNeeded(channel uint8) *IE {
+	return newUint8ValIE(ChannelNeeded, channel&0x0f)
+}
+
+// ChannelNeeded returns ChannelNeeded in uint8 if the type of IE matches.
+func (i *IE) ChannelNeeded() (uint8, error) {
+	if i.Type != ChannelNeeded {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0] & 0x0f, nil
+}
+
+// MustChannelNeeded returns ChannelNeeded in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustChannelNeeded() uint8 {
+	v, _ := i.ChannelNeeded()
+	return v
+}
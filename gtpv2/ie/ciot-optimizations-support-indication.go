@@ -0,0 +1,75 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewCIoTOptimizationsSupportIndication creates a new CIoTOptimizationsSupportIndication IE.
+func NewCIoTOptimizationsSupportIndication(ihcsi, awopdn, scnipdn, sgnipdn uint8) *IE {
+	i := New(CIoTOptimizationsSupportIndication, 0x00, make([]byte, 1))
+	i.Payload[0] |= (ihcsi << 3 & 0x08) | (awopdn << 2 & 0x04) | (scnipdn << 1 & 0x02) | (sgnipdn & 0x01)
+	return i
+}
+
+// CIoTOptimizationsSupportIndication returns CIoTOptimizationsSupportIndication in uint8
+// if the type of IE matches.
+func (i *IE) CIoTOptimizationsSupportIndication() (uint8, error) {
+	if i.Type != CIoTOptimizationsSupportIndication {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustCIoTOptimizationsSupportIndication returns CIoTOptimizationsSupportIndication in uint8,
+// ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustCIoTOptimizationsSupportIndication() uint8 {
+	v, _ := i.CIoTOptimizationsSupportIndication()
+	return v
+}
+
+// HasSGNIPDN reports whether an IE has SGNIPDN bit.
+func (i *IE) HasSGNIPDN() bool {
+	v, err := i.CIoTOptimizationsSupportIndication()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
+
+// HasSCNIPDN reports whether an IE has SCNIPDN bit.
+func (i *IE) HasSCNIPDN() bool {
+	v, err := i.CIoTOptimizationsSupportIndication()
+	if err != nil {
+		return false
+	}
+
+	return has2ndBit(v)
+}
+
+// HasAWOPDN reports whether an IE has AWOPDN bit.
+func (i *IE) HasAWOPDN() bool {
+	v, err := i.CIoTOptimizationsSupportIndication()
+	if err != nil {
+		return false
+	}
+
+	return has3rdBit(v)
+}
+
+// HasIHCSI reports whether an IE has IHCSI bit.
+func (i *IE) HasIHCSI() bool {
+	v, err := i.CIoTOptimizationsSupportIndication()
+	if err != nil {
+		return false
+	}
+
+	return has4thBit(v)
+}
@@ -0,0 +1,71 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestCIoTIEs(t *testing.T) {
+	ts := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("APNRateControlStatus", func(t *testing.T) {
+		got, err := ie.NewAPNRateControlStatus(10, 1, 20, ts).APNRateControlStatus()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(ie.NewAPNRateControlStatusFields(10, 1, 20, ts), got); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("ServingPLMNRateControl", func(t *testing.T) {
+		i := ie.NewServingPLMNRateControl(10, 20)
+		if ul, dl := i.MustUplinkRateLimit(), i.MustDownlinkRateLimit(); ul != 10 || dl != 20 {
+			t.Errorf("got UL %d, DL %d, want UL 10, DL 20", ul, dl)
+		}
+	})
+
+	t.Run("HeaderCompressionConfiguration", func(t *testing.T) {
+		i := ie.NewHeaderCompressionConfiguration(gtpv2.ROHCProfileESPIPv2, 0x3fff)
+		if p, cid := i.MustROHCProfiles(), i.MustMaxCID(); p != gtpv2.ROHCProfileESPIPv2 || cid != 0x3fff {
+			t.Errorf("got profiles %x, MAX_CID %x", p, cid)
+		}
+	})
+
+	t.Run("Counter", func(t *testing.T) {
+		i := ie.NewCounter(ts, 5)
+		if got := i.MustCounterTimestamp(); !got.Equal(ts) {
+			t.Errorf("got timestamp %s, want %s", got, ts)
+		}
+		if got := i.MustCounterValue(); got != 5 {
+			t.Errorf("got counter %d, want 5", got)
+		}
+	})
+
+	t.Run("SCEFPDNConnection", func(t *testing.T) {
+		scefID := ie.New(ie.NodeIdentifier, 0x00, []byte{0x04, 0x73, 0x63, 0x65, 0x66, 0x02, 0x65, 0x78})
+		i := ie.NewSCEFPDNConnection(ie.NewAccessPointName("some.apn"), ie.NewEPSBearerID(5), scefID)
+		ies := i.MustSCEFPDNConnection()
+		if len(ies) != 3 || ies[2].Type != ie.NodeIdentifier {
+			t.Fatalf("unexpected IEs in SCEFPDNConnection: %v", ies)
+		}
+		if i.HasIHCSI() {
+			t.Error("IHCSI should not be set in SCEFPDNConnection")
+		}
+	})
+
+	t.Run("CIoTOptimizationsSupportIndication", func(t *testing.T) {
+		i := ie.NewCIoTOptimizationsSupportIndication(1, 1, 0, 0)
+		if !i.HasIHCSI() || !i.HasAWOPDN() || i.HasSCNIPDN() || i.HasSGNIPDN() {
+			t.Errorf("unexpected flags: %08b", i.MustCIoTOptimizationsSupportIndication())
+		}
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// NewCounter creates a new Counter IE.
+func NewCounter(ts time.Time, counter uint8) *IE {
+	b := make([]byte, 5)
+	binary.BigEndian.PutUint32(b[0:4], timeToNTPSeconds(ts))
+	b[4] = counter
+	return New(Counter, 0x00, b)
+}
+
+// CounterTimestamp returns Timestamp in Counter in time.Time if the type of IE matches.
+func (i *IE) CounterTimestamp() (time.Time, error) {
+	if i.Type != Counter {
+		return time.Time{}, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 4 {
+		return time.Time{}, io.ErrUnexpectedEOF
+	}
+
+	return ntpSecondsToTime(binary.BigEndian.Uint32(i.Payload[0:4])), nil
+}
+
+// MustCounterTimestamp returns CounterTimestamp in time.Time, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustCounterTimestamp() time.Time {
+	v, _ := i.CounterTimestamp()
+	return v
+}
+
+// CounterValue returns CounterValue in uint8 if the type of IE matches.
+func (i *IE) CounterValue() (uint8, error) {
+	if i.Type != Counter {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 5 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[4], nil
+}
+
+// MustCounterValue returns CounterValue in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustCounterValue() uint8 {
+	v, _ := i.CounterValue()
+	return v
+}
@@ -0,0 +1,33 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewEMLPPPriority creates a new EMLPPPriority IE.
+//
+// The value should be the eMLPP Priority defined in TS 24.008.
+func NewEMLPPPriority(prio uint8) *IE {
+	return newUint8ValIE(EMLPPPriority, prio&0x07)
+}
+
+// EMLPPPriority returns EMLPPPriority in uint8 if the type of IE matches.
+func (i *IE) EMLPPPriority() (uint8, error) {
+	if i.Type != EMLPPPriority {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0] & 0x07, nil
+}
+
+// MustEMLPPPriority returns EMLPPPriority in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustEMLPPPriority() uint8 {
+	v, _ := i.EMLPPPriority()
+	return v
+}
@@ -5,7 +5,7 @@
 package ie
 
 import (
-	"errors"
+	"fmt"
 	"io"
 	"math"
 	"time"
@@ -91,7 +91,17 @@ func (i *IE) Timer() (time.Duration, error) {
 		}
 		return d, nil
 	case OverloadControlInformation:
-		return 0, errors.New("not implemented")
+		ies, err := i.OverloadControlInformation()
+		if err != nil {
+			return 0, fmt.Errorf("failed to retrieve EPCTimer: %w", err)
+		}
+
+		for _, child := range ies {
+			if child.Type == EPCTimer {
+				return child.Timer()
+			}
+		}
+		return 0, ErrIENotFound
 	default:
 		return 0, &InvalidTypeError{Type: i.Type}
 	}
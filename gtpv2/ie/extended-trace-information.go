@@ -0,0 +1,198 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"net"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewExtendedTraceInformation creates a new ExtendedTraceInformation IE.
+//
+// Unlike TraceInformation, the length of triggeringEvents, neTypes and interfaces
+// is not fixed. ip is the IP Address of Trace Collection Entity in IPv4 or IPv6.
+func NewExtendedTraceInformation(mcc, mnc string, traceID uint32, triggeringEvents, neTypes []byte, depth uint8, interfaces []byte, ip string) *IE {
+	v := NewExtendedTraceInformationFields(mcc, mnc, traceID, triggeringEvents, neTypes, depth, interfaces, net.ParseIP(ip))
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(ExtendedTraceInformation, 0x00, b)
+}
+
+// ExtendedTraceInformation returns ExtendedTraceInformation in ExtendedTraceInformationFields
+// type if the type of IE matches.
+func (i *IE) ExtendedTraceInformation() (*ExtendedTraceInformationFields, error) {
+	switch i.Type {
+	case ExtendedTraceInformation:
+		return ParseExtendedTraceInformationFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// ExtendedTraceInformationFields is a set of fields in ExtendedTraceInformation IE.
+type ExtendedTraceInformationFields struct {
+	MCC, MNC                         string
+	TraceID                          uint32 // 24-bit
+	TriggeringEvents                 []byte
+	ListOfNETypes                    []byte
+	SessionTraceDepth                uint8
+	ListOfInterfaces                 []byte
+	IPAddressOfTraceCollectionEntity net.IP
+}
+
+// NewExtendedTraceInformationFields creates a new ExtendedTraceInformationFields.
+func NewExtendedTraceInformationFields(mcc, mnc string, traceID uint32, triggeringEvents, neTypes []byte, depth uint8, interfaces []byte, ip net.IP) *ExtendedTraceInformationFields {
+	f := &ExtendedTraceInformationFields{
+		MCC:                              mcc,
+		MNC:                              mnc,
+		TraceID:                          traceID,
+		TriggeringEvents:                 triggeringEvents,
+		ListOfNETypes:                    neTypes,
+		SessionTraceDepth:                depth,
+		ListOfInterfaces:                 interfaces,
+		IPAddressOfTraceCollectionEntity: ip,
+	}
+
+	if v := ip.To4(); v != nil {
+		f.IPAddressOfTraceCollectionEntity = v
+	}
+	return f
+}
+
+// Marshal serializes ExtendedTraceInformationFields.
+func (f *ExtendedTraceInformationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes ExtendedTraceInformationFields.
+func (f *ExtendedTraceInformationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	plmn, err := utils.EncodePLMN(f.MCC, f.MNC)
+	if err != nil {
+		return err
+	}
+	copy(b[0:3], plmn)
+	copy(b[3:6], utils.Uint32To24(f.TraceID))
+	offset := 6
+
+	b[offset] = uint8(len(f.TriggeringEvents))
+	offset++
+	copy(b[offset:], f.TriggeringEvents)
+	offset += len(f.TriggeringEvents)
+
+	b[offset] = uint8(len(f.ListOfNETypes))
+	offset++
+	copy(b[offset:], f.ListOfNETypes)
+	offset += len(f.ListOfNETypes)
+
+	b[offset] = f.SessionTraceDepth
+	offset++
+
+	b[offset] = uint8(len(f.ListOfInterfaces))
+	offset++
+	copy(b[offset:], f.ListOfInterfaces)
+	offset += len(f.ListOfInterfaces)
+
+	b[offset] = uint8(len(f.IPAddressOfTraceCollectionEntity))
+	offset++
+	copy(b[offset:], f.IPAddressOfTraceCollectionEntity)
+
+	return nil
+}
+
+// ParseExtendedTraceInformationFields decodes ExtendedTraceInformationFields.
+func ParseExtendedTraceInformationFields(b []byte) (*ExtendedTraceInformationFields, error) {
+	f := &ExtendedTraceInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into ExtendedTraceInformationFields.
+func (f *ExtendedTraceInformationFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 7 {
+		return io.ErrUnexpectedEOF
+	}
+
+	var err error
+	f.MCC, f.MNC, err = utils.DecodePLMN(b[0:3])
+	if err != nil {
+		return err
+	}
+	f.TraceID = utils.Uint24To32(b[3:6])
+	offset := 6
+
+	// readLV reads the length-prefixed value at offset.
+	readLV := func() ([]byte, error) {
+		if l <= offset {
+			return nil, io.ErrUnexpectedEOF
+		}
+		n := int(b[offset])
+		offset++
+		if l < offset+n {
+			return nil, io.ErrUnexpectedEOF
+		}
+		v := make([]byte, n)
+		copy(v, b[offset:offset+n])
+		offset += n
+		return v, nil
+	}
+
+	f.TriggeringEvents, err = readLV()
+	if err != nil {
+		return err
+	}
+	f.ListOfNETypes, err = readLV()
+	if err != nil {
+		return err
+	}
+
+	if l <= offset {
+		return io.ErrUnexpectedEOF
+	}
+	f.SessionTraceDepth = b[offset]
+	offset++
+
+	f.ListOfInterfaces, err = readLV()
+	if err != nil {
+		return err
+	}
+
+	ip, err := readLV()
+	if err != nil {
+		return err
+	}
+	switch len(ip) {
+	case 0:
+	case 4, 16:
+		f.IPAddressOfTraceCollectionEntity = net.IP(ip)
+	default:
+		return ErrMalformed
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of ExtendedTraceInformationFields in int.
+func (f *ExtendedTraceInformationFields) MarshalLen() int {
+	return 6 + 1 + len(f.TriggeringEvents) + 1 + len(f.ListOfNETypes) + 1 +
+		1 + len(f.ListOfInterfaces) + 1 + len(f.IPAddressOfTraceCollectionEntity)
+}
@@ -0,0 +1,93 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewFCause creates a new FCause IE.
+//
+// The causeType is used only for S1-AP Cause, and it should be zero for the others.
+func NewFCause(causeType uint8, cause []byte) *IE {
+	v := NewFCauseFields(causeType, cause)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(FCause, 0x00, b)
+}
+
+// FCause returns FCause in FCauseFields type if the type of IE matches.
+func (i *IE) FCause() (*FCauseFields, error) {
+	switch i.Type {
+	case FCause:
+		return ParseFCauseFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// FCauseFields is a set of fields in FCause IE.
+type FCauseFields struct {
+	CauseType uint8  // 4-bit
+	Cause     []byte // S1-AP, RANAP or BSSGP Cause, depending on the instance of IE
+}
+
+// NewFCauseFields creates a new FCauseFields.
+func NewFCauseFields(causeType uint8, cause []byte) *FCauseFields {
+	return &FCauseFields{
+		CauseType: causeType,
+		Cause:     cause,
+	}
+}
+
+// Marshal serializes FCauseFields.
+func (f *FCauseFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes FCauseFields.
+func (f *FCauseFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.CauseType & 0x0f
+	copy(b[1:], f.Cause)
+
+	return nil
+}
+
+// ParseFCauseFields decodes FCauseFields.
+func ParseFCauseFields(b []byte) (*FCauseFields, error) {
+	f := &FCauseFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into FCauseFields.
+func (f *FCauseFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.CauseType = b[0] & 0x0f
+	f.Cause = b[1:]
+
+	return nil
+}
+
+// MarshalLen returns the serial length of FCauseFields in int.
+func (f *FCauseFields) MarshalLen() int {
+	return 1 + len(f.Cause)
+}
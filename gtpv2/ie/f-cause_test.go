@@ -0,0 +1,54 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestFCause(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		fields      *ie.FCauseFields
+	}{
+		{
+			"S1APCause",
+			ie.NewFCause(gtpv2.CauseTypeRadioNetworkLayer, []byte{0x02}),
+			&ie.FCauseFields{CauseType: gtpv2.CauseTypeRadioNetworkLayer, Cause: []byte{0x02}},
+		}, {
+			"EmptyCause",
+			ie.NewFCause(gtpv2.CauseTypeRadioNetworkLayer, nil),
+			&ie.FCauseFields{CauseType: gtpv2.CauseTypeRadioNetworkLayer},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			b, err := c.structured.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			i, err := ie.Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := i.FCause()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.fields, cmpopts.EquateEmpty()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
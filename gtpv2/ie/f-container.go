@@ -0,0 +1,126 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"fmt"
+	"io"
+)
+
+// NewFContainer creates a new FContainer IE.
+func NewFContainer(containerType uint8, container []byte) *IE {
+	v := NewFContainerFields(containerType, container)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(FContainer, 0x00, b)
+}
+
+// FContainer returns FContainer in FContainerFields type if the type of IE matches.
+func (i *IE) FContainer() (*FContainerFields, error) {
+	switch i.Type {
+	case FContainer:
+		return ParseFContainerFields(i.Payload)
+	case BearerContext:
+		ies, err := i.BearerContext()
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve FContainer: %w", err)
+		}
+
+		for _, child := range ies {
+			if child.Type == FContainer {
+				return child.FContainer()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// ContainerType returns ContainerType in uint8 if the type of IE matches.
+func (i *IE) ContainerType() (uint8, error) {
+	switch i.Type {
+	case FContainer:
+		if len(i.Payload) < 1 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return i.Payload[0] & 0x0f, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustContainerType returns ContainerType in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustContainerType() uint8 {
+	v, _ := i.ContainerType()
+	return v
+}
+
+// FContainerFields is a set of fields in FContainer IE.
+type FContainerFields struct {
+	ContainerType uint8  // 4-bit
+	Container     []byte // format depends on ContainerType
+}
+
+// NewFContainerFields creates a new FContainerFields.
+func NewFContainerFields(containerType uint8, container []byte) *FContainerFields {
+	return &FContainerFields{
+		ContainerType: containerType,
+		Container:     container,
+	}
+}
+
+// Marshal serializes FContainerFields.
+func (f *FContainerFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes FContainerFields.
+func (f *FContainerFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.ContainerType & 0x0f
+	copy(b[1:], f.Container)
+
+	return nil
+}
+
+// ParseFContainerFields decodes FContainerFields.
+func ParseFContainerFields(b []byte) (*FContainerFields, error) {
+	f := &FContainerFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into FContainerFields.
+func (f *FContainerFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.ContainerType = b[0] & 0x0f
+	f.Container = b[1:]
+
+	return nil
+}
+
+// MarshalLen returns the serial length of FContainerFields in int.
+func (f *FContainerFields) MarshalLen() int {
+	return 1 + len(f.Container)
+}
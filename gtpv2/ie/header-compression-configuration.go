@@ -0,0 +1,59 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewHeaderCompressionConfiguration creates a new HeaderCompressionConfiguration IE.
+//
+// profiles is the bitmap of the ROHC profiles supported, which is the first octet
+// of ROHC Profiles field (the second one is spare).
+func NewHeaderCompressionConfiguration(profiles uint8, maxCID uint16) *IE {
+	b := make([]byte, 4)
+	b[0] = profiles & 0x7f
+	binary.BigEndian.PutUint16(b[2:4], maxCID)
+	return New(HeaderCompressionConfiguration, 0x00, b)
+}
+
+// ROHCProfiles returns the bitmap of ROHC Profiles in uint8 if the type of IE matches.
+func (i *IE) ROHCProfiles() (uint8, error) {
+	if i.Type != HeaderCompressionConfiguration {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0] & 0x7f, nil
+}
+
+// MustROHCProfiles returns ROHCProfiles in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustROHCProfiles() uint8 {
+	v, _ := i.ROHCProfiles()
+	return v
+}
+
+// MaxCID returns MAX_CID in uint16 if the type of IE matches.
+func (i *IE) MaxCID() (uint16, error) {
+	if i.Type != HeaderCompressionConfiguration {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return binary.BigEndian.Uint16(i.Payload[2:4]), nil
+}
+
+// MustMaxCID returns MaxCID in uint16, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMaxCID() uint16 {
+	v, _ := i.MaxCID()
+	return v
+}
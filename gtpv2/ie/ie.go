@@ -19,6 +19,17 @@ const (
 	Cause                                                uint8 = 2
 	Recovery                                             uint8 = 3
 	STNSR                                                uint8 = 51
+	SourceToTargetTransparentContainer                   uint8 = 52
+	TargetToSourceTransparentContainer                   uint8 = 53
+	MMContextForEUTRANSRVCC                              uint8 = 54
+	MMContextForUTRANSRVCC                               uint8 = 55
+	SRVCCCause                                           uint8 = 56
+	TargetRNCID                                          uint8 = 57
+	TargetGlobalCellID                                   uint8 = 58
+	TEIDC                                                uint8 = 59
+	SvFlags                                              uint8 = 60
+	ServiceAreaIdentifier                                uint8 = 61
+	MMContextForCSToPSSRVCC                              uint8 = 62
 	AccessPointName                                      uint8 = 71
 	AggregateMaximumBitRate                              uint8 = 72
 	EPSBearerID                                          uint8 = 73
@@ -473,6 +484,17 @@ var ieTypeNameMap = map[uint8]string{
 	2:   "Cause",
 	3:   "Recovery",
 	51:  "STNSR",
+	52:  "SourceToTargetTransparentContainer",
+	53:  "TargetToSourceTransparentContainer",
+	54:  "MMContextForEUTRANSRVCC",
+	55:  "MMContextForUTRANSRVCC",
+	56:  "SRVCCCause",
+	57:  "TargetRNCID",
+	58:  "TargetGlobalCellID",
+	59:  "TEIDC",
+	60:  "SvFlags",
+	61:  "ServiceAreaIdentifier",
+	62:  "MMContextForCSToPSSRVCC",
 	71:  "AccessPointName",
 	72:  "AggregateMaximumBitRate",
 	73:  "EPSBearerID",
@@ -519,8 +541,8 @@ var ieTypeNameMap = map[uint8]string{
 	117: "GUTI",
 	118: "FContainer",
 	119: "FCause",
-	121: "PLMNID",
-	122: "TargetIdentification",
+	120: "PLMNID",
+	121: "TargetIdentification",
 	123: "PacketFlowID",
 	124: "RABContext",
 	125: "SourceRNCPDCPContextInfo",
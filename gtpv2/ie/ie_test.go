@@ -5,6 +5,7 @@
 package ie_test
 
 import (
+	"bytes"
 	"net"
 	"testing"
 	"time"
@@ -17,6 +18,10 @@ import (
 var (
 	mac1, _ = net.ParseMAC("12:34:56:78:90:01")
 	mac2, _ = net.ParseMAC("12:34:56:78:90:02")
+
+	ck = []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	ik = []byte{0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20}
+	kc = []byte{0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28}
 )
 
 var cases = []struct {
@@ -40,6 +45,58 @@ var cases = []struct {
 		"Recovery",
 		ie.NewRecovery(0xff),
 		[]byte{0x03, 0x00, 0x01, 0x00, 0xff},
+	}, {
+		"STNSR",
+		ie.NewSTNSR(0x91, "81901234567"),
+		[]byte{0x33, 0x00, 0x07, 0x00, 0x91, 0x18, 0x09, 0x21, 0x43, 0x65, 0xf7},
+	}, {
+		"SourceToTargetTransparentContainer",
+		ie.NewSourceToTargetTransparentContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+		[]byte{0x34, 0x00, 0x05, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef},
+	}, {
+		"TargetToSourceTransparentContainer",
+		ie.NewTargetToSourceTransparentContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+		[]byte{0x35, 0x00, 0x05, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef},
+	}, {
+		"MMContextForEUTRANSRVCC",
+		ie.NewMMContextForEUTRANSRVCC(1, ck, ik, []byte{0x53, 0x19, 0xa2}, []byte{0x60, 0x14}, []byte{0x04, 0x02, 0x60, 0x04}),
+		[]byte{0x36, 0x00, 0x2d, 0x00, 0x01, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x03, 0x53, 0x19, 0xa2, 0x02, 0x60, 0x14, 0x04, 0x04, 0x02, 0x60, 0x04},
+	}, {
+		"MMContextForUTRANSRVCC",
+		ie.NewMMContextForUTRANSRVCC(1, ck, ik, kc, 2, []byte{0x53, 0x19, 0xa2}, []byte{0x60, 0x14}, []byte{0x04, 0x02, 0x60, 0x04}),
+		[]byte{0x37, 0x00, 0x36, 0x00, 0x01, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x02, 0x03, 0x53, 0x19, 0xa2, 0x02, 0x60, 0x14, 0x04, 0x04, 0x02, 0x60, 0x04},
+	}, {
+		"SRVCCCause",
+		ie.NewSRVCCCause(gtpv2.SRVCCCauseUnspecified),
+		[]byte{0x38, 0x00, 0x01, 0x00, 0x01},
+	}, {
+		"TargetRNCID",
+		ie.NewTargetRNCID("123", "45", 0x1111, 0x22, 0x3333, 0),
+		[]byte{0x39, 0x00, 0x08, 0x00, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x33, 0x33},
+	}, {
+		"TargetRNCID/ExtendedRNCID",
+		ie.NewTargetRNCID("123", "45", 0x1111, 0x22, 0x3333, 0x4444),
+		[]byte{0x39, 0x00, 0x0a, 0x00, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x33, 0x33, 0x44, 0x44},
+	}, {
+		"TargetGlobalCellID",
+		ie.NewTargetGlobalCellID("123", "45", 0x1111, 0x2222),
+		[]byte{0x3a, 0x00, 0x07, 0x00, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x22},
+	}, {
+		"TEIDC",
+		ie.NewTEIDC(0x11223344),
+		[]byte{0x3b, 0x00, 0x04, 0x00, 0x11, 0x22, 0x33, 0x44},
+	}, {
+		"SvFlags",
+		ie.NewSvFlags(0, 1, 1),
+		[]byte{0x3c, 0x00, 0x01, 0x00, 0x03},
+	}, {
+		"ServiceAreaIdentifier",
+		ie.NewServiceAreaIdentifier("123", "45", 0x1111, 0x3333),
+		[]byte{0x3d, 0x00, 0x07, 0x00, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x33, 0x33},
+	}, {
+		"MMContextForCSToPSSRVCC",
+		ie.NewMMContextForCSToPSSRVCC(1, ck, ik, kc, 2),
+		[]byte{0x3e, 0x00, 0x2a, 0x00, 0x01, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x02},
 	}, {
 		"AccessPointName",
 		ie.NewAccessPointName("some.apn.example"),
@@ -547,10 +604,111 @@ var cases = []struct {
 		"PDNType",
 		ie.NewPDNType(gtpv2.PDNTypeIPv4),
 		[]byte{0x63, 0x00, 0x01, 0x00, 0x01},
+	}, {
+		"TraceInformation/v4",
+		ie.NewTraceInformation(
+			"123", "45", 1,
+			[]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09},
+			0x0301, gtpv2.TraceDepthMaximum,
+			[]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+			"1.1.1.1",
+		),
+		[]byte{
+			0x60, 0x00, 0x22, 0x00,
+			// MCC/MNC, Trace ID
+			0x21, 0xf3, 0x54, 0x00, 0x00, 0x01,
+			// Triggering Events
+			0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09,
+			// List of NE Types, Session Trace Depth
+			0x03, 0x01, 0x02,
+			// List of Interfaces
+			0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c,
+			// IP Address of Trace Collection Entity
+			0x01, 0x01, 0x01, 0x01,
+		},
+	}, {
+		"TraceInformation/v6",
+		ie.NewTraceInformation(
+			"123", "45", 1,
+			[]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09},
+			0x0301, gtpv2.TraceDepthMaximum,
+			[]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+			"2001::1",
+		),
+		[]byte{
+			0x60, 0x00, 0x2e, 0x00,
+			// MCC/MNC, Trace ID
+			0x21, 0xf3, 0x54, 0x00, 0x00, 0x01,
+			// Triggering Events
+			0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09,
+			// List of NE Types, Session Trace Depth
+			0x03, 0x01, 0x02,
+			// List of Interfaces
+			0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c,
+			// IP Address of Trace Collection Entity
+			0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+		},
 	}, {
 		"ProcedureTransactionID",
 		ie.NewProcedureTransactionID(1),
 		[]byte{0x64, 0x00, 0x01, 0x00, 0x01},
+	}, {
+		"MMContextGSMKeyAndTriplets",
+		ie.NewMMContextGSMKeyAndTriplets(
+			2, 1, bytes.Repeat([]byte{0x22}, 8),
+			ie.NewAuthenticationTriplet(bytes.Repeat([]byte{0x33}, 16), bytes.Repeat([]byte{0x44}, 4), bytes.Repeat([]byte{0x55}, 8)),
+		),
+		[]byte{
+			0x67, 0x00, 0x2a, 0x00, 0x02, 0x20, 0x01, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x44,
+			0x44, 0x44, 0x44, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x55, 0x00, 0x00, 0x00,
+		},
+	}, {
+		"MMContextUMTSKeyAndQuintuplets",
+		ie.NewMMContextUMTSKeyAndQuintuplets(
+			3, bytes.Repeat([]byte{0xaa}, 16), bytes.Repeat([]byte{0xbb}, 16),
+			ie.NewAuthenticationQuintuplet(
+				bytes.Repeat([]byte{0x01}, 16), bytes.Repeat([]byte{0x02}, 4), bytes.Repeat([]byte{0x03}, 16), bytes.Repeat([]byte{0x04}, 16), bytes.Repeat([]byte{0x05}, 16),
+			),
+		),
+		[]byte{
+			0x6a, 0x00, 0x6c, 0x00, 0x63, 0x20, 0x00, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa,
+			0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xaa, 0xbb, 0xbb, 0xbb, 0xbb, 0xbb, 0xbb, 0xbb, 0xbb, 0xbb,
+			0xbb, 0xbb, 0xbb, 0xbb, 0xbb, 0xbb, 0xbb, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01,
+			0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x04, 0x02, 0x02, 0x02, 0x02, 0x03, 0x03, 0x03, 0x03,
+			0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x04, 0x04, 0x04, 0x04,
+			0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x10, 0x05, 0x05, 0x05,
+			0x05, 0x05, 0x05, 0x05, 0x05, 0x05, 0x05, 0x05, 0x05, 0x05, 0x05, 0x05, 0x05, 0x00, 0x00, 0x00,
+		},
+	}, {
+		"MMContextEPSSecurityContextQuadrupletsAndQuintuplets",
+		ie.NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets(
+			1, 2, 1, 1, 2, bytes.Repeat([]byte{0x11}, 32),
+			[]*ie.AuthenticationQuadruplet{
+				ie.NewAuthenticationQuadruplet(bytes.Repeat([]byte{0x01}, 16), bytes.Repeat([]byte{0x02}, 8), bytes.Repeat([]byte{0x03}, 16), bytes.Repeat([]byte{0x04}, 32)),
+			},
+			nil, []byte{0xe0, 0xe0},
+		),
+		[]byte{
+			0x6b, 0x00, 0x78, 0x00, 0x81, 0x04, 0x21, 0x00, 0x00, 0x01, 0x00, 0x00, 0x02, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x01, 0x01, 0x01,
+			0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x08, 0x02, 0x02,
+			0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x10, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03,
+			0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04,
+			0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04,
+			0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x02, 0xe0, 0xe0, 0x00, 0x00,
+		},
+	}, {
+		"PDNConnection",
+		ie.NewPDNConnection(
+			ie.NewAccessPointName("some.apn"),
+			ie.NewEPSBearerID(0x05),
+		),
+		[]byte{
+			0x6d, 0x00, 0x12, 0x00, 0x47, 0x00, 0x09, 0x00, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70,
+			0x6e, 0x49, 0x00, 0x01, 0x00, 0x05,
+		},
 	}, {
 		"PacketTMSI",
 		ie.NewPacketTMSI(0xdeadbeef),
@@ -579,6 +737,14 @@ var cases = []struct {
 		"GUTI",
 		ie.NewGUTI("123", "45", 0x1111, 0x22, 0x33333333),
 		[]byte{0x75, 0x00, 0x0a, 0x00, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x33, 0x33, 0x33, 0x33},
+	}, {
+		"FContainer",
+		ie.NewFContainer(gtpv2.FContainerTypeEUTRANTransparentContainer, []byte{0xde, 0xad, 0xbe, 0xef}),
+		[]byte{0x76, 0x00, 0x05, 0x00, 0x03, 0xde, 0xad, 0xbe, 0xef},
+	}, {
+		"FCause",
+		ie.NewFCause(gtpv2.CauseTypeRadioNetworkLayer, []byte{0x02}),
+		[]byte{0x77, 0x00, 0x02, 0x00, 0x00, 0x02},
 	}, {
 		"PLMNID/2digits",
 		ie.NewPLMNID("123", "45"),
@@ -587,6 +753,42 @@ var cases = []struct {
 		"PLMNID/3digits",
 		ie.NewPLMNID("123", "456"),
 		[]byte{0x78, 0x00, 0x03, 0x00, 0x21, 0x63, 0x54},
+	}, {
+		"TargetIdentification/RNCID",
+		ie.NewTargetIdentificationRNCID("123", "45", 0x1111, 0x22, 0x0333),
+		[]byte{0x79, 0x00, 0x09, 0x00, 0x00, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x03, 0x33},
+	}, {
+		"TargetIdentification/MacroENodeBID",
+		ie.NewTargetIdentificationMacroENodeBID("123", "45", 0x12345, 0x0001),
+		[]byte{0x79, 0x00, 0x09, 0x00, 0x01, 0x21, 0xf3, 0x54, 0x01, 0x23, 0x45, 0x00, 0x01},
+	}, {
+		"TargetIdentification/CellIdentifier",
+		ie.NewTargetIdentificationCellIdentifier("123", "45", 0x1111, 0x22, 0x3333),
+		[]byte{0x79, 0x00, 0x09, 0x00, 0x02, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x33, 0x33},
+	}, {
+		"TargetIdentification/HomeENodeBID",
+		ie.NewTargetIdentificationHomeENodeBID("123", "45", 0x1234567, 0x0001),
+		[]byte{0x79, 0x00, 0x0a, 0x00, 0x03, 0x21, 0xf3, 0x54, 0x01, 0x23, 0x45, 0x67, 0x00, 0x01},
+	}, {
+		"TargetIdentification/ExtendedMacroENodeBID",
+		ie.NewTargetIdentificationExtendedMacroENodeBID("123", "45", true, 0x12345, 0x0001),
+		[]byte{0x79, 0x00, 0x09, 0x00, 0x04, 0x21, 0xf3, 0x54, 0x81, 0x23, 0x45, 0x00, 0x01},
+	}, {
+		"TargetIdentification/GNodeBID",
+		ie.NewTargetIdentificationGNodeBID("123", "45", 22, 0x123456, 0x000001),
+		[]byte{0x79, 0x00, 0x0c, 0x00, 0x05, 0x21, 0xf3, 0x54, 0x16, 0x00, 0x12, 0x34, 0x56, 0x00, 0x00, 0x01},
+	}, {
+		"TargetIdentification/MacroNGENodeBID",
+		ie.NewTargetIdentificationMacroNGENodeBID("123", "45", 0x12345, 0x000001),
+		[]byte{0x79, 0x00, 0x0a, 0x00, 0x06, 0x21, 0xf3, 0x54, 0x01, 0x23, 0x45, 0x00, 0x00, 0x01},
+	}, {
+		"TargetIdentification/ExtendedNGENodeBID",
+		ie.NewTargetIdentificationExtendedNGENodeBID("123", "45", false, 0x12345, 0x000001),
+		[]byte{0x79, 0x00, 0x0a, 0x00, 0x07, 0x21, 0xf3, 0x54, 0x01, 0x23, 0x45, 0x00, 0x00, 0x01},
+	}, {
+		"SourceRNCPDCPContextInfo",
+		ie.NewSourceRNCPDCPContextInfo([]byte{0xde, 0xad, 0xbe, 0xef}),
+		[]byte{0x7d, 0x00, 0x04, 0x00, 0xde, 0xad, 0xbe, 0xef},
 	}, {
 		"PortNumber",
 		ie.NewPortNumber(2123),
@@ -599,6 +801,21 @@ var cases = []struct {
 		"SelectionMode",
 		ie.NewSelectionMode(gtpv2.SelectionModeMSProvidedAPNSubscriptionNotVerified),
 		[]byte{0x80, 0x00, 0x01, 0x00, 0x01},
+	}, {
+		"SourceIdentification",
+		ie.NewSourceIdentification(
+			ie.NewCellIdentifier("123", "45", 0x1111, 0x22, 0x3333),
+			gtpv2.SourceTypeRNCID,
+			ie.NewCellIdentifier("123", "45", 0x1111, 0x22, 0x0444),
+		),
+		[]byte{
+			0x81, 0x00, 0x11, 0x00, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x33, 0x33, 0x01, 0x21, 0xf3, 0x54,
+			0x11, 0x11, 0x22, 0x04, 0x44,
+		},
+	}, {
+		"ChangeReportingAction",
+		ie.NewChangeReportingAction(gtpv2.ChangeReportingActionStartReportingTAIAndECGI),
+		[]byte{0x83, 0x00, 0x01, 0x00, 0x06},
 	}, {
 		"FullyQualifiedCSID/v4",
 		ie.NewFullyQualifiedCSID("1.1.1.1", 1),
@@ -615,6 +832,14 @@ var cases = []struct {
 		"FullyQualifiedCSID/other",
 		ie.NewFullyQualifiedCSID("12304501", 1),
 		[]byte{0x84, 0x00, 0x07, 0x00, 0x21, 0x12, 0x30, 0x45, 0x01, 0x00, 0x01},
+	}, {
+		"ChannelNeeded",
+		ie.NewChannelNeeded(0x05),
+		[]byte{0x85, 0x00, 0x01, 0x00, 0x05},
+	}, {
+		"EMLPPPriority",
+		ie.NewEMLPPPriority(0x03),
+		[]byte{0x86, 0x00, 0x01, 0x00, 0x03},
 	}, {
 		"NodeType",
 		ie.NewNodeType(gtpv2.NodeTypeMME),
@@ -623,6 +848,54 @@ var cases = []struct {
 		"FullyQualifiedDomainName",
 		ie.NewFullyQualifiedDomainName("some-fqdn.example"),
 		[]byte{0x88, 0x00, 0x12, 0x00, 0x09, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x66, 0x71, 0x64, 0x6e, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65},
+	}, {
+		"MBMSSessionDuration",
+		ie.NewMBMSSessionDuration(25 * time.Hour),
+		[]byte{0x8a, 0x00, 0x03, 0x00, 0x07, 0x08, 0x01},
+	}, {
+		"MBMSServiceArea",
+		ie.NewMBMSServiceArea(1, 2),
+		[]byte{0x8b, 0x00, 0x05, 0x00, 0x01, 0x00, 0x01, 0x00, 0x02},
+	}, {
+		"MBMSSessionIdentifier",
+		ie.NewMBMSSessionIdentifier(1),
+		[]byte{0x8c, 0x00, 0x01, 0x00, 0x01},
+	}, {
+		"MBMSFlowIdentifier",
+		ie.NewMBMSFlowIdentifier(0x1111),
+		[]byte{0x8d, 0x00, 0x02, 0x00, 0x11, 0x11},
+	}, {
+		"MBMSIPMulticastDistribution/v4",
+		ie.NewMBMSIPMulticastDistribution(0x11111111, "239.0.0.1", "1.1.1.1", gtpv2.MBMSHCIndicatorCompressedHeader),
+		[]byte{
+			0x8e, 0x00, 0x0f, 0x00,
+			// Common TEID
+			0x11, 0x11, 0x11, 0x11,
+			// IP Multicast Distribution Address
+			0x04, 0xef, 0x00, 0x00, 0x01,
+			// IP Multicast Source Address
+			0x04, 0x01, 0x01, 0x01, 0x01,
+			// MBMS HC Indicator
+			0x01,
+		},
+	}, {
+		"MBMSIPMulticastDistribution/v6",
+		ie.NewMBMSIPMulticastDistribution(0x11111111, "ff0e::1", "2001::1", gtpv2.MBMSHCIndicatorUncompressedHeader),
+		[]byte{
+			0x8e, 0x00, 0x27, 0x00,
+			// Common TEID
+			0x11, 0x11, 0x11, 0x11,
+			// IP Multicast Distribution Address
+			0x50, 0xff, 0x0e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+			// IP Multicast Source Address
+			0x50, 0x20, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+			// MBMS HC Indicator
+			0x00,
+		},
+	}, {
+		"MBMSDistributionAcknowledge",
+		ie.NewMBMSDistributionAcknowledge(gtpv2.MBMSDistributionSomeRNCsAccepted),
+		[]byte{0x8f, 0x00, 0x01, 0x00, 0x02},
 	}, {
 		"RFSPIndex",
 		ie.NewRFSPIndex(1),
@@ -655,6 +928,10 @@ var cases = []struct {
 		"NodeFeatures",
 		ie.NewNodeFeatures(0x01),
 		[]byte{0x98, 0x00, 0x01, 0x00, 0x01},
+	}, {
+		"MBMSTimeToDataTransfer",
+		ie.NewMBMSTimeToDataTransfer(10 * time.Second),
+		[]byte{0x99, 0x00, 0x01, 0x00, 0x09},
 	}, {
 		"Throttling",
 		ie.NewThrottling(20*time.Hour, 80),
@@ -667,6 +944,61 @@ var cases = []struct {
 		"EPCTimer",
 		ie.NewEPCTimer(20 * time.Hour),
 		[]byte{0x9c, 0x00, 0x01, 0x00, 0x82},
+	}, {
+		"SignallingPriorityIndication",
+		ie.NewSignallingPriorityIndication(1),
+		[]byte{0x9d, 0x00, 0x01, 0x00, 0x01},
+	}, {
+		"TMGI",
+		ie.NewTMGI(0x123456, "123", "45"),
+		[]byte{0x9e, 0x00, 0x06, 0x00, 0x12, 0x34, 0x56, 0x21, 0xf3, 0x54},
+	}, {
+		"TMGI/NoPLMN",
+		ie.NewTMGI(0x123456, "", ""),
+		[]byte{0x9e, 0x00, 0x03, 0x00, 0x12, 0x34, 0x56},
+	}, {
+		"AdditionalMMContextForSRVCC",
+		ie.NewAdditionalMMContextForSRVCC([]byte{0x53, 0x19, 0xa2}, []byte{0x60, 0x14}, []byte{0x04, 0x02, 0x60, 0x04}),
+		[]byte{0x9f, 0x00, 0x0c, 0x00, 0x03, 0x53, 0x19, 0xa2, 0x02, 0x60, 0x14, 0x04, 0x04, 0x02, 0x60, 0x04},
+	}, {
+		"AdditionalFlagsForSRVCC",
+		ie.NewAdditionalFlagsForSRVCC(0, 1),
+		[]byte{0xa0, 0x00, 0x01, 0x00, 0x01},
+	}, {
+		"AbsoluteTimeofMBMSDataTransfer",
+		ie.NewAbsoluteTimeofMBMSDataTransfer(time.Date(2019, time.January, 1, 0, 0, 0, 500000000, time.UTC)),
+		[]byte{0xa4, 0x00, 0x08, 0x00, 0xdf, 0xd5, 0x2c, 0x00, 0x80, 0x00, 0x00, 0x00},
+	}, {
+		"ActionIndication",
+		ie.NewActionIndication(gtpv2.ActionIndicationDeactivationIndication),
+		[]byte{0xa8, 0x00, 0x01, 0x00, 0x01},
+	}, {
+		"TWANIdentifier",
+		ie.NewTWANIdentifier(
+			"ssid", net.HardwareAddr{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc}, []byte{0xde, 0xad},
+			&ie.PLMN{MCC: "123", MNC: "45"}, []byte("op"), gtpv2.RelayIdentityTypeFQDN, []byte("relay"), []byte{0x01},
+		),
+		[]byte{
+			0xa9, 0x00, 0x1e, 0x00, 0x1f,
+			// SSID
+			0x04, 0x73, 0x73, 0x69, 0x64,
+			// BSSID
+			0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc,
+			// Civic Address
+			0x02, 0xde, 0xad,
+			// TWAN PLMN-ID
+			0x21, 0xf3, 0x54,
+			// TWAN Operator Name
+			0x02, 0x6f, 0x70,
+			// Relay Identity
+			0x01, 0x05, 0x72, 0x65, 0x6c, 0x61, 0x79,
+			// Circuit-ID
+			0x01, 0x01,
+		},
+	}, {
+		"TWANIdentifier/SSIDOnly",
+		ie.NewTWANIdentifier("ssid", nil, nil, nil, nil, 0, nil, nil),
+		[]byte{0xa9, 0x00, 0x06, 0x00, 0x00, 0x04, 0x73, 0x73, 0x69, 0x64},
 	}, {
 		"ULITimestamp",
 		ie.NewULITimestamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
@@ -679,6 +1011,87 @@ var cases = []struct {
 		"RANNASCause",
 		ie.NewRANNASCause(gtpv2.ProtoTypeS1APCause, gtpv2.CauseTypeNAS, []byte{0x01}),
 		[]byte{0xac, 0x00, 0x02, 0x00, 0x12, 0x01},
+	}, {
+		"TrustedWLANModeIndication",
+		ie.NewTrustedWLANModeIndication(1, 0),
+		[]byte{0xae, 0x00, 0x01, 0x00, 0x02},
+	}, {
+		"PresenceReportingAreaAction",
+		ie.NewPresenceReportingAreaAction(&ie.PresenceReportingAreaActionFields{
+			Action:              gtpv2.PresenceReportingAreaActionStartReporting,
+			PRAID:               0x010203,
+			TAIs:                []*ie.TAI{ie.NewTAI("123", "45", 0x1111)},
+			MacroENBIDs:         []*ie.MENBI{ie.NewMENBI("123", "45", 0x022222)},
+			ECGIs:               []*ie.ECGI{ie.NewECGI("123", "45", 0x3333333)},
+			ExtendedMacroENBIDs: []*ie.EMENBI{ie.NewEMENBI("123", "45", 0x044444)},
+		}),
+		[]byte{
+			0xb1, 0x00, 0x23, 0x00, 0x01, 0x01, 0x02, 0x03, 0x10, 0x01, 0x00, 0x01, 0x00, 0x00,
+			// TAI
+			0x21, 0xf3, 0x54, 0x11, 0x11,
+			// Macro eNB ID
+			0x21, 0xf3, 0x54, 0x02, 0x22, 0x22,
+			// ECGI
+			0x21, 0xf3, 0x54, 0x03, 0x33, 0x33, 0x33,
+			// Extended Macro eNB ID
+			0x01, 0x21, 0xf3, 0x54, 0x04, 0x44, 0x44,
+		},
+	}, {
+		"PresenceReportingAreaAction/StopReporting",
+		ie.NewPresenceReportingAreaAction(
+			ie.NewPresenceReportingAreaActionFields(gtpv2.PresenceReportingAreaActionStopReporting, 0x010203),
+		),
+		[]byte{0xb1, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x02, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	}, {
+		"PresenceReportingAreaInformation",
+		ie.NewPresenceReportingAreaInformation(0x010203, 0, 0, 0, 1),
+		[]byte{0xb2, 0x00, 0x04, 0x00, 0x01, 0x02, 0x03, 0x01},
+	}, {
+		"TWANIdentifierTimestamp",
+		ie.NewTWANIdentifierTimestamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		[]byte{0xb3, 0x00, 0x04, 0x00, 0xdf, 0xd5, 0x2c, 0x00},
+	}, {
+		"OverloadControlInformation",
+		ie.NewOverloadControlInformation(1, 50, 10*time.Minute, "some.apn"),
+		[]byte{
+			0xb4, 0x00, 0x1f, 0x00,
+			// SequenceNumber
+			0xb7, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x01,
+			// Metric
+			0xb6, 0x00, 0x01, 0x00, 0x32,
+			// EPCTimer
+			0x9c, 0x00, 0x01, 0x00, 0x41,
+			// APN
+			0x47, 0x00, 0x09, 0x00, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e,
+		},
+	}, {
+		"LoadControlInformation",
+		ie.NewLoadControlInformation(2, 80, ie.NewAPNAndRelativeCapacity(50, "some.apn")),
+		[]byte{
+			0xb5, 0x00, 0x1c, 0x00,
+			// SequenceNumber
+			0xb7, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x02,
+			// Metric
+			0xb6, 0x00, 0x01, 0x00, 0x50,
+			// APNAndRelativeCapacity
+			0xb8, 0x00, 0x0b, 0x00, 0x32, 0x09, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e,
+		},
+	}, {
+		"Metric",
+		ie.NewMetric(50),
+		[]byte{0xb6, 0x00, 0x01, 0x00, 0x32},
+	}, {
+		"SequenceNumber",
+		ie.NewSequenceNumber(0xdeadbeef),
+		[]byte{0xb7, 0x00, 0x04, 0x00, 0xde, 0xad, 0xbe, 0xef},
+	}, {
+		"APNAndRelativeCapacity",
+		ie.NewAPNAndRelativeCapacity(100, "some.apn"),
+		[]byte{0xb8, 0x00, 0x0b, 0x00, 0x64, 0x09, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e},
+	}, {
+		"WLANOffloadabilityIndication",
+		ie.NewWLANOffloadabilityIndication(1, 1),
+		[]byte{0xb9, 0x00, 0x01, 0x00, 0x03},
 	}, {
 		"PagingAndServiceInformation",
 		ie.NewPagingAndServiceInformation(5, 0x01, 0xff),
@@ -687,6 +1100,165 @@ var cases = []struct {
 		"IntegerNumber",
 		ie.NewIntegerNumber(2020),
 		[]byte{0xbb, 0x00, 0x02, 0x00, 0x07, 0xe4},
+	}, {
+		"RemoteUEContext",
+		ie.NewRemoteUEContext(
+			ie.NewRemoteUserID("123451234567890", "819012345678", "123450123456789"),
+			ie.NewRemoteUEIPinformation([]byte{0x01, 0x7f, 0x00, 0x00, 0x01}),
+		),
+		[]byte{0xbf, 0x00, 0x27, 0x00, 0xc0, 0x00, 0x1a, 0x00, 0x03, 0x08, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0, 0x06, 0x18, 0x09, 0x21, 0x43, 0x65, 0x87, 0x08, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9, 0xc1, 0x00, 0x05, 0x00, 0x01, 0x7f, 0x00, 0x00, 0x01},
+	}, {
+		"RemoteUEContext/Disconnected",
+		ie.NewRemoteUEContext(ie.NewRemoteUserID("123451234567890", "", ""), nil).WithInstance(1),
+		[]byte{0xbf, 0x00, 0x0e, 0x01, 0xc0, 0x00, 0x0a, 0x00, 0x00, 0x08, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0},
+	}, {
+		"RemoteUserID",
+		ie.NewRemoteUserID("123451234567890", "819012345678", "123450123456789"),
+		[]byte{0xc0, 0x00, 0x1a, 0x00, 0x03, 0x08, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0, 0x06, 0x18, 0x09, 0x21, 0x43, 0x65, 0x87, 0x08, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9},
+	}, {
+		"RemoteUserID/IMSIOnly",
+		ie.NewRemoteUserID("123451234567890", "", ""),
+		[]byte{0xc0, 0x00, 0x0a, 0x00, 0x00, 0x08, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0},
+	}, {
+		"RemoteUEIPinformation",
+		ie.NewRemoteUEIPinformation([]byte{0x01, 0x7f, 0x00, 0x00, 0x01}),
+		[]byte{0xc1, 0x00, 0x05, 0x00, 0x01, 0x7f, 0x00, 0x00, 0x01},
+	}, {
+		"CIoTOptimizationsSupportIndication",
+		ie.NewCIoTOptimizationsSupportIndication(1, 0, 1, 0),
+		[]byte{0xc2, 0x00, 0x01, 0x00, 0x0a},
+	}, {
+		"SCEFPDNConnection",
+		ie.NewSCEFPDNConnection(
+			ie.NewAccessPointName("some.apn"),
+			ie.NewEPSBearerID(5),
+			ie.New(ie.NodeIdentifier, 0x00, []byte{0x04, 0x73, 0x63, 0x65, 0x66, 0x02, 0x65, 0x78}),
+		),
+		[]byte{
+			0xc3, 0x00, 0x1e, 0x00,
+			// APN
+			0x47, 0x00, 0x09, 0x00, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e,
+			// EBI
+			0x49, 0x00, 0x01, 0x00, 0x05,
+			// Node Identifier
+			0xb0, 0x00, 0x08, 0x00, 0x04, 0x73, 0x63, 0x65, 0x66, 0x02, 0x65, 0x78,
+		},
+	}, {
+		"HeaderCompressionConfiguration",
+		ie.NewHeaderCompressionConfiguration(gtpv2.ROHCProfileRTPUDPIP|gtpv2.ROHCProfileUDPIP, 15),
+		[]byte{0xc4, 0x00, 0x04, 0x00, 0x03, 0x00, 0x00, 0x0f},
+	}, {
+		"ServingPLMNRateControl",
+		ie.NewServingPLMNRateControl(0x1111, 0x2222),
+		[]byte{0xc6, 0x00, 0x04, 0x00, 0x11, 0x11, 0x22, 0x22},
+	}, {
+		"Counter",
+		ie.NewCounter(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), 1),
+		[]byte{0xc7, 0x00, 0x05, 0x00, 0xdf, 0xd5, 0x2c, 0x00, 0x01},
+	}, {
+		"MappedUEUsageType",
+		ie.NewMappedUEUsageType(0x1234),
+		[]byte{0xc8, 0x00, 0x02, 0x00, 0x12, 0x34},
+	}, {
+		"SecondaryRATUsageDataReport",
+		ie.NewSecondaryRATUsageDataReport(
+			0, 1, gtpv2.SecondaryRATTypeNR, 5,
+			time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, time.January, 1, 1, 0, 0, 0, time.UTC),
+			1000000, 500000,
+		),
+		[]byte{
+			0xc9, 0x00, 0x1b, 0x00,
+			// Flags, Secondary RAT Type, EBI
+			0x01, 0x00, 0x05,
+			// Start/End Timestamp
+			0xdf, 0xd5, 0x2c, 0x00, 0xdf, 0xd5, 0x3a, 0x10,
+			// Usage Data DL/UL
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x42, 0x40,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0xa1, 0x20,
+		},
+	}, {
+		"APNRateControlStatus",
+		ie.NewAPNRateControlStatus(1, 2, 3, time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		[]byte{
+			0xcc, 0x00, 0x14, 0x00,
+			// Uplink packets, Additional exception reports, Downlink packets
+			0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x03,
+			// Validity Time
+			0xdf, 0xd5, 0x2c, 0x00, 0x00, 0x00, 0x00, 0x00,
+		},
+	}, {
+		"ExtendedTraceInformation",
+		ie.NewExtendedTraceInformation(
+			"123", "45", 1,
+			[]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09},
+			[]byte{0x03, 0x01}, gtpv2.TraceDepthMaximum,
+			[]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c},
+			"1.1.1.1",
+		),
+		[]byte{
+			0xcd, 0x00, 0x26, 0x00,
+			// MCC/MNC, Trace ID
+			0x21, 0xf3, 0x54, 0x00, 0x00, 0x01,
+			// Triggering Events
+			0x09, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09,
+			// List of NE Types, Session Trace Depth
+			0x02, 0x03, 0x01, 0x02,
+			// List of Interfaces
+			0x0c, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c,
+			// IP Address of Trace Collection Entity
+			0x04, 0x01, 0x01, 0x01, 0x01,
+		},
+	}, {
+		"V2XContext",
+		ie.NewV2XContext(
+			ie.NewServicesAuthorized(gtpv2.ServicesAuthorizedAuthorized, gtpv2.ServicesAuthorizedNotAuthorized),
+			ie.NewServicesAuthorized(gtpv2.ServicesAuthorizedAuthorized, gtpv2.ServicesAuthorizedAuthorized),
+			ie.NewBitRate(100000),
+			ie.NewBitRate(200000),
+			ie.NewPC5QoSParameters(ie.NewBitRate(50000), ie.NewPC5QoSFlow(21, 1000, 2000, 0)),
+		),
+		[]byte{
+			0xd0, 0x00, 0x36, 0x00,
+			// LTE V2X Services Authorized
+			0xd2, 0x00, 0x02, 0x00, 0x00, 0x01,
+			// NR V2X Services Authorized
+			0xd2, 0x00, 0x02, 0x01, 0x00, 0x00,
+			// LTE UE Sidelink AMBR
+			0xd3, 0x00, 0x04, 0x00, 0x00, 0x01, 0x86, 0xa0,
+			// NR UE PC5 AMBR
+			0xd3, 0x00, 0x04, 0x01, 0x00, 0x03, 0x0d, 0x40,
+			// PC5 QoS Parameters
+			0xd1, 0x00, 0x16, 0x00,
+			0xd4, 0x00, 0x0a, 0x00, 0x15, 0x00, 0x00, 0x03, 0xe8, 0x00, 0x00, 0x07, 0xd0, 0x00,
+			0xd3, 0x00, 0x04, 0x00, 0x00, 0x00, 0xc3, 0x50,
+		},
+	}, {
+		"PC5QoSParameters",
+		ie.NewPC5QoSParameters(
+			ie.NewBitRate(50000),
+			ie.NewPC5QoSFlow(21, 1000, 2000, 0),
+			ie.NewPC5QoSFlow(90, 0, 0, 3),
+		),
+		[]byte{
+			0xd1, 0x00, 0x25, 0x00,
+			// PC5 QoS Flows
+			0xd4, 0x00, 0x0a, 0x00, 0x15, 0x00, 0x00, 0x03, 0xe8, 0x00, 0x00, 0x07, 0xd0, 0x00,
+			0xd4, 0x00, 0x0b, 0x00, 0x5a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x03,
+			// PC5 Link Aggregated Bit Rates
+			0xd3, 0x00, 0x04, 0x00, 0x00, 0x00, 0xc3, 0x50,
+		},
+	}, {
+		"ServicesAuthorized",
+		ie.NewServicesAuthorized(gtpv2.ServicesAuthorizedAuthorized, gtpv2.ServicesAuthorizedNotAuthorized),
+		[]byte{0xd2, 0x00, 0x02, 0x00, 0x00, 0x01},
+	}, {
+		"BitRate",
+		ie.NewBitRate(100000),
+		[]byte{0xd3, 0x00, 0x04, 0x00, 0x00, 0x01, 0x86, 0xa0},
+	}, {
+		"PC5QoSFlow",
+		ie.NewPC5QoSFlow(21, 1000, 2000, 3),
+		[]byte{0xd4, 0x00, 0x0b, 0x00, 0x15, 0x00, 0x00, 0x03, 0xe8, 0x00, 0x00, 0x07, 0xd0, 0x01, 0x03},
 	}, {
 		"PrivateExtension",
 		ie.NewPrivateExtension(10415, []byte{0xde, 0xad, 0xbe, 0xef}),
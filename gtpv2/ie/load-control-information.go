@@ -0,0 +1,36 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewLoadControlInformation creates a new LoadControlInformation IE.
+//
+// The APNAndRelativeCapacity IEs given are put as the List of APN and Relative Capacity,
+// which means the load applies only to those APNs.
+func NewLoadControlInformation(seq uint32, metric uint8, apnCapacities ...*IE) *IE {
+	ies := []*IE{
+		NewSequenceNumber(seq),
+		NewMetric(metric),
+	}
+	for _, i := range apnCapacities {
+		if i != nil {
+			ies = append(ies, i)
+		}
+	}
+	return newGroupedIE(LoadControlInformation, ies...)
+}
+
+// LoadControlInformation returns the IEs above LoadControlInformation if the type of IE matches.
+func (i *IE) LoadControlInformation() ([]*IE, error) {
+	if i.Type != LoadControlInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return ParseMultiIEs(i.Payload)
+}
@@ -0,0 +1,34 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewMappedUEUsageType creates a new MappedUEUsageType IE.
+func NewMappedUEUsageType(usageType uint16) *IE {
+	return newUint16ValIE(MappedUEUsageType, usageType)
+}
+
+// MappedUEUsageType returns MappedUEUsageType in uint16 if the type of IE matches.
+func (i *IE) MappedUEUsageType() (uint16, error) {
+	if i.Type != MappedUEUsageType {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 2 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return binary.BigEndian.Uint16(i.Payload[0:2]), nil
+}
+
+// MustMappedUEUsageType returns MappedUEUsageType in uint16, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMappedUEUsageType() uint16 {
+	v, _ := i.MappedUEUsageType()
+	return v
+}
@@ -0,0 +1,31 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewMBMSDistributionAcknowledge creates a new MBMSDistributionAcknowledge IE.
+func NewMBMSDistributionAcknowledge(indication uint8) *IE {
+	return newUint8ValIE(MBMSDistributionAcknowledge, indication&0x03)
+}
+
+// MBMSDistributionAcknowledge returns the Distribution Indication in uint8 if the type of IE matches.
+func (i *IE) MBMSDistributionAcknowledge() (uint8, error) {
+	if i.Type != MBMSDistributionAcknowledge {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0] & 0x03, nil
+}
+
+// MustMBMSDistributionAcknowledge returns the Distribution Indication in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSDistributionAcknowledge() uint8 {
+	v, _ := i.MBMSDistributionAcknowledge()
+	return v
+}
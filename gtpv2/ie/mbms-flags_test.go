@@ -0,0 +1,64 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestMBMSFlags(t *testing.T) {
+	cases := []struct {
+		description  string
+		lmri, msri   uint8
+		release, est bool
+	}{
+		{"None", 0, 0, false, false},
+		{"LMRI", 1, 0, true, false},
+		{"MSRI", 0, 1, false, true},
+		{"Both", 1, 1, true, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			i := ie.NewMBMSFlags(c.lmri, c.msri)
+			if got := i.LocalMBMSBearerContextRelease(); got != c.release {
+				t.Errorf("unexpected LocalMBMSBearerContextRelease: got %v, want %v", got, c.release)
+			}
+			if got := i.MBMSSessionReEstablishment(); got != c.est {
+				t.Errorf("unexpected MBMSSessionReEstablishment: got %v, want %v", got, c.est)
+			}
+		})
+	}
+}
+
+func TestMBMSValues(t *testing.T) {
+	if got := ie.NewMBMSSessionDuration(25*time.Hour + 30*time.Second).MustMBMSSessionDuration(); got != 25*time.Hour+30*time.Second {
+		t.Errorf("unexpected MBMSSessionDuration: got %s", got)
+	}
+	if got := ie.NewMBMSTimeToDataTransfer(256 * time.Second).MustMBMSTimeToDataTransfer(); got != 256*time.Second {
+		t.Errorf("unexpected MBMSTimeToDataTransfer: got %s", got)
+	}
+
+	sais := ie.NewMBMSServiceArea(1, 2, 3).MustMBMSServiceArea()
+	if len(sais) != 3 || sais[0] != 1 || sais[2] != 3 {
+		t.Errorf("unexpected MBMSServiceArea: got %v", sais)
+	}
+
+	ts := time.Date(2019, time.January, 1, 0, 0, 0, 250000000, time.UTC)
+	if got := ie.NewAbsoluteTimeofMBMSDataTransfer(ts).MustAbsoluteTimeofMBMSDataTransfer(); !got.Equal(ts) {
+		t.Errorf("unexpected AbsoluteTimeofMBMSDataTransfer: got %s, want %s", got, ts)
+	}
+
+	tmgi, err := ie.NewTMGI(0x123456, "123", "45").TMGI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tmgi.MBMSServiceID != 0x123456 || tmgi.MCC != "123" || tmgi.MNC != "45" {
+		t.Errorf("unexpected TMGI: got %+v", tmgi)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+)
+
+// NewMBMSIPMulticastDistribution creates a new MBMSIPMulticastDistribution IE.
+func NewMBMSIPMulticastDistribution(cteid uint32, distAddr, srcAddr string, hcIndicator uint8) *IE {
+	v := NewMBMSIPMulticastDistributionFields(cteid, net.ParseIP(distAddr), net.ParseIP(srcAddr), hcIndicator)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MBMSIPMulticastDistribution, 0x00, b)
+}
+
+// MBMSIPMulticastDistribution returns MBMSIPMulticastDistribution in
+// MBMSIPMulticastDistributionFields type if the type of IE matches.
+func (i *IE) MBMSIPMulticastDistribution() (*MBMSIPMulticastDistributionFields, error) {
+	switch i.Type {
+	case MBMSIPMulticastDistribution:
+		return ParseMBMSIPMulticastDistributionFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MBMSIPMulticastDistributionFields is a set of fields in MBMSIPMulticastDistribution IE.
+type MBMSIPMulticastDistributionFields struct {
+	CommonTEID                     uint32
+	IPMulticastDistributionAddress net.IP
+	IPMulticastSourceAddress       net.IP
+	MBMSHCIndicator                uint8
+}
+
+// NewMBMSIPMulticastDistributionFields creates a new MBMSIPMulticastDistributionFields.
+func NewMBMSIPMulticastDistributionFields(cteid uint32, distAddr, srcAddr net.IP, hcIndicator uint8) *MBMSIPMulticastDistributionFields {
+	f := &MBMSIPMulticastDistributionFields{
+		CommonTEID:                     cteid,
+		IPMulticastDistributionAddress: distAddr,
+		IPMulticastSourceAddress:       srcAddr,
+		MBMSHCIndicator:                hcIndicator,
+	}
+
+	if v := distAddr.To4(); v != nil {
+		f.IPMulticastDistributionAddress = v
+	}
+	if v := srcAddr.To4(); v != nil {
+		f.IPMulticastSourceAddress = v
+	}
+	return f
+}
+
+// Marshal serializes MBMSIPMulticastDistributionFields.
+func (f *MBMSIPMulticastDistributionFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes MBMSIPMulticastDistributionFields.
+func (f *MBMSIPMulticastDistributionFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	binary.BigEndian.PutUint32(b[0:4], f.CommonTEID)
+	offset := 4
+
+	n, err := marshalTypedAddress(b[offset:], f.IPMulticastDistributionAddress)
+	if err != nil {
+		return err
+	}
+	offset += n
+
+	n, err = marshalTypedAddress(b[offset:], f.IPMulticastSourceAddress)
+	if err != nil {
+		return err
+	}
+	offset += n
+
+	b[offset] = f.MBMSHCIndicator
+	return nil
+}
+
+// ParseMBMSIPMulticastDistributionFields decodes MBMSIPMulticastDistributionFields.
+func ParseMBMSIPMulticastDistributionFields(b []byte) (*MBMSIPMulticastDistributionFields, error) {
+	f := &MBMSIPMulticastDistributionFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into MBMSIPMulticastDistributionFields.
+func (f *MBMSIPMulticastDistributionFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 5 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.CommonTEID = binary.BigEndian.Uint32(b[0:4])
+	offset := 4
+
+	var (
+		n   int
+		err error
+	)
+	f.IPMulticastDistributionAddress, n, err = parseTypedAddress(b[offset:])
+	if err != nil {
+		return err
+	}
+	offset += n
+
+	f.IPMulticastSourceAddress, n, err = parseTypedAddress(b[offset:])
+	if err != nil {
+		return err
+	}
+	offset += n
+
+	if len(b) <= offset {
+		return io.ErrUnexpectedEOF
+	}
+	f.MBMSHCIndicator = b[offset]
+
+	return nil
+}
+
+// MarshalLen returns the serial length of MBMSIPMulticastDistributionFields in int.
+func (f *MBMSIPMulticastDistributionFields) MarshalLen() int {
+	return 4 + 1 + len(f.IPMulticastDistributionAddress) + 1 + len(f.IPMulticastSourceAddress) + 1
+}
+
+// marshalTypedAddress serializes the IP address with the preceding octet of
+// Address Type (bits 8-7) and Address Length (bits 6-1), and returns the length.
+func marshalTypedAddress(b []byte, ip net.IP) (int, error) {
+	switch len(ip) {
+	case net.IPv4len:
+		b[0] = net.IPv4len
+	case net.IPv6len:
+		b[0] = 0x40 | net.IPv6len
+	default:
+		return 0, ErrMalformed
+	}
+
+	copy(b[1:], ip)
+	return 1 + len(ip), nil
+}
+
+// parseTypedAddress decodes the IP address with the preceding octet of Address Type
+// and Address Length, and returns the length consumed.
+func parseTypedAddress(b []byte) (net.IP, int, error) {
+	if len(b) < 1 {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	l := int(b[0] & 0x3f)
+	switch b[0] >> 6 {
+	case 0:
+		if l != net.IPv4len {
+			return nil, 0, ErrMalformed
+		}
+	case 1:
+		if l != net.IPv6len {
+			return nil, 0, ErrMalformed
+		}
+	default:
+		return nil, 0, ErrMalformed
+	}
+	if len(b) < 1+l {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+
+	ip := make(net.IP, l)
+	copy(ip, b[1:1+l])
+	return ip, 1 + l, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestMBMSIPMulticastDistribution(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		fields      *ie.MBMSIPMulticastDistributionFields
+	}{
+		{
+			"v4",
+			ie.NewMBMSIPMulticastDistribution(0x11111111, "239.0.0.1", "1.1.1.1", gtpv2.MBMSHCIndicatorCompressedHeader),
+			&ie.MBMSIPMulticastDistributionFields{
+				CommonTEID:                     0x11111111,
+				IPMulticastDistributionAddress: net.IP{239, 0, 0, 1},
+				IPMulticastSourceAddress:       net.IP{1, 1, 1, 1},
+				MBMSHCIndicator:                gtpv2.MBMSHCIndicatorCompressedHeader,
+			},
+		}, {
+			"v6",
+			ie.NewMBMSIPMulticastDistribution(0x11111111, "ff0e::1", "2001::1", gtpv2.MBMSHCIndicatorUncompressedHeader),
+			&ie.MBMSIPMulticastDistributionFields{
+				CommonTEID:                     0x11111111,
+				IPMulticastDistributionAddress: net.ParseIP("ff0e::1"),
+				IPMulticastSourceAddress:       net.ParseIP("2001::1"),
+				MBMSHCIndicator:                gtpv2.MBMSHCIndicatorUncompressedHeader,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := c.structured.MBMSIPMulticastDistribution()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.fields); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+
+	if ie.NewMBMSIPMulticastDistribution(0x11111111, "", "1.1.1.1", 0) != nil {
+		t.Error("MBMSIPMulticastDistribution without address should not be created")
+	}
+}
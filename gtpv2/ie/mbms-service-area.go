@@ -0,0 +1,57 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewMBMSServiceArea creates a new MBMSServiceArea IE.
+//
+// At least one and at most 256 MBMS Service Area Identities should be given.
+func NewMBMSServiceArea(sais ...uint16) *IE {
+	if len(sais) == 0 || len(sais) > 256 {
+		return nil
+	}
+
+	b := make([]byte, 1+len(sais)*2)
+	b[0] = uint8(len(sais) - 1)
+	for n, sai := range sais {
+		binary.BigEndian.PutUint16(b[1+n*2:3+n*2], sai)
+	}
+
+	return New(MBMSServiceArea, 0x00, b)
+}
+
+// MBMSServiceArea returns the list of MBMS Service Area Identities in []uint16
+// if the type of IE matches.
+func (i *IE) MBMSServiceArea() ([]uint16, error) {
+	if i.Type != MBMSServiceArea {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	n := int(i.Payload[0]) + 1
+	if len(i.Payload) < 1+n*2 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	sais := make([]uint16, n)
+	for x := range sais {
+		sais[x] = binary.BigEndian.Uint16(i.Payload[1+x*2 : 3+x*2])
+	}
+	return sais, nil
+}
+
+// MustMBMSServiceArea returns the list of MBMS Service Area Identities in []uint16,
+// ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSServiceArea() []uint16 {
+	v, _ := i.MBMSServiceArea()
+	return v
+}
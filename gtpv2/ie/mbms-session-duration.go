@@ -0,0 +1,46 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"time"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewMBMSSessionDuration creates a new MBMSSessionDuration IE.
+//
+// The duration is encoded as the days and the seconds, with the precision of a second.
+// The days over 127 are truncated.
+func NewMBMSSessionDuration(duration time.Duration) *IE {
+	days := uint32(duration/(24*time.Hour)) & 0x7f
+	secs := uint32((duration%(24*time.Hour))/time.Second) & 0x1ffff
+
+	return New(MBMSSessionDuration, 0x00, utils.Uint32To24(secs<<7|days))
+}
+
+// MBMSSessionDuration returns MBMSSessionDuration in time.Duration if the type of IE matches.
+func (i *IE) MBMSSessionDuration() (time.Duration, error) {
+	if i.Type != MBMSSessionDuration {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 3 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	v := utils.Uint24To32(i.Payload[0:3])
+	days := time.Duration(v&0x7f) * 24 * time.Hour
+	secs := time.Duration(v>>7) * time.Second
+
+	return days + secs, nil
+}
+
+// MustMBMSSessionDuration returns MBMSSessionDuration in time.Duration, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSSessionDuration() time.Duration {
+	v, _ := i.MBMSSessionDuration()
+	return v
+}
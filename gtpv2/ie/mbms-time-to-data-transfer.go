@@ -0,0 +1,44 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"time"
+)
+
+// NewMBMSTimeToDataTransfer creates a new MBMSTimeToDataTransfer IE.
+//
+// The duration should be between 1 and 256 seconds.
+func NewMBMSTimeToDataTransfer(duration time.Duration) *IE {
+	secs := int(duration.Seconds())
+	switch {
+	case secs < 1:
+		secs = 1
+	case secs > 256:
+		secs = 256
+	}
+
+	return newUint8ValIE(MBMSTimeToDataTransfer, uint8(secs-1))
+}
+
+// MBMSTimeToDataTransfer returns MBMSTimeToDataTransfer in time.Duration if the type of IE matches.
+func (i *IE) MBMSTimeToDataTransfer() (time.Duration, error) {
+	if i.Type != MBMSTimeToDataTransfer {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return time.Duration(int(i.Payload[0])+1) * time.Second, nil
+}
+
+// MustMBMSTimeToDataTransfer returns MBMSTimeToDataTransfer in time.Duration, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSTimeToDataTransfer() time.Duration {
+	v, _ := i.MBMSTimeToDataTransfer()
+	return v
+}
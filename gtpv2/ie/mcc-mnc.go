@@ -24,7 +24,8 @@ func (i *IE) MCC() (string, error) {
 			return "", err
 		}
 		return mcc, nil
-	case GlobalCNID, TraceReference, GUTI, UserCSGInformation:
+	case GlobalCNID, TraceReference, TraceInformation, ExtendedTraceInformation,
+		GUTI, UserCSGInformation, TargetRNCID, TargetGlobalCellID, ServiceAreaIdentifier:
 		mcc, _, err := utils.DecodePLMN(i.Payload[:3])
 		if err != nil {
 			return "", err
@@ -79,7 +80,8 @@ func (i *IE) MNC() (string, error) {
 			return "", err
 		}
 		return mnc, nil
-	case GlobalCNID, TraceReference, GUTI, UserCSGInformation:
+	case GlobalCNID, TraceReference, TraceInformation, ExtendedTraceInformation,
+		GUTI, UserCSGInformation, TargetRNCID, TargetGlobalCellID, ServiceAreaIdentifier:
 		_, mnc, err := utils.DecodePLMN(i.Payload[:3])
 		if err != nil {
 			return "", err
@@ -0,0 +1,53 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"fmt"
+	"io"
+)
+
+// NewMetric creates a new Metric IE.
+//
+// The value is the Overload Reduction Metric or the Load Metric in percentage,
+// in the range of 0 to 100.
+func NewMetric(metric uint8) *IE {
+	return newUint8ValIE(Metric, metric)
+}
+
+// Metric returns Metric in uint8 if the type of IE matches.
+//
+// For OverloadControlInformation, it is the Overload Reduction Metric, and for
+// LoadControlInformation, it is the Load Metric.
+func (i *IE) Metric() (uint8, error) {
+	switch i.Type {
+	case Metric:
+		if len(i.Payload) < 1 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return i.Payload[0], nil
+	case OverloadControlInformation, LoadControlInformation:
+		ies, err := ParseMultiIEs(i.Payload)
+		if err != nil {
+			return 0, fmt.Errorf("failed to retrieve Metric: %w", err)
+		}
+
+		for _, child := range ies {
+			if child.Type == Metric {
+				return child.Metric()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustMetric returns Metric in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMetric() uint8 {
+	v, _ := i.Metric()
+	return v
+}
@@ -0,0 +1,107 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewMMContextForCSToPSSRVCC creates a new MMContextForCSToPSSRVCC IE.
+func NewMMContextForCSToPSSRVCC(ksiPS uint8, ckPS, ikPS, kcPS []byte, cksnPS uint8) *IE {
+	v := NewMMContextForCSToPSSRVCCFields(ksiPS, ckPS, ikPS, kcPS, cksnPS)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MMContextForCSToPSSRVCC, 0x00, b)
+}
+
+// MMContextForCSToPSSRVCC returns MMContextForCSToPSSRVCC in
+// MMContextForCSToPSSRVCCFields type if the type of IE matches.
+func (i *IE) MMContextForCSToPSSRVCC() (*MMContextForCSToPSSRVCCFields, error) {
+	switch i.Type {
+	case MMContextForCSToPSSRVCC:
+		return ParseMMContextForCSToPSSRVCCFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MMContextForCSToPSSRVCCFields is a set of fields in MMContextForCSToPSSRVCC IE.
+type MMContextForCSToPSSRVCCFields struct {
+	KSIPS  uint8  // 3-bit
+	CKPS   []byte // 16 octets
+	IKPS   []byte // 16 octets
+	KcPS   []byte // 8 octets
+	CKSNPS uint8  // 3-bit
+}
+
+// NewMMContextForCSToPSSRVCCFields creates a new MMContextForCSToPSSRVCCFields.
+func NewMMContextForCSToPSSRVCCFields(ksiPS uint8, ckPS, ikPS, kcPS []byte, cksnPS uint8) *MMContextForCSToPSSRVCCFields {
+	return &MMContextForCSToPSSRVCCFields{
+		KSIPS:  ksiPS,
+		CKPS:   ckPS,
+		IKPS:   ikPS,
+		KcPS:   kcPS,
+		CKSNPS: cksnPS,
+	}
+}
+
+// Marshal serializes MMContextForCSToPSSRVCCFields.
+func (f *MMContextForCSToPSSRVCCFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes MMContextForCSToPSSRVCCFields.
+func (f *MMContextForCSToPSSRVCCFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+	if len(f.CKPS) > 16 || len(f.IKPS) > 16 || len(f.KcPS) > 8 {
+		return ErrMalformed
+	}
+
+	b[0] = f.KSIPS & 0x07
+	copy(b[1:17], f.CKPS)
+	copy(b[17:33], f.IKPS)
+	copy(b[33:41], f.KcPS)
+	b[41] = f.CKSNPS & 0x07
+
+	return nil
+}
+
+// ParseMMContextForCSToPSSRVCCFields decodes MMContextForCSToPSSRVCCFields.
+func ParseMMContextForCSToPSSRVCCFields(b []byte) (*MMContextForCSToPSSRVCCFields, error) {
+	f := &MMContextForCSToPSSRVCCFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into MMContextForCSToPSSRVCCFields.
+func (f *MMContextForCSToPSSRVCCFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 42 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.KSIPS = b[0] & 0x07
+	f.CKPS = b[1:17]
+	f.IKPS = b[17:33]
+	f.KcPS = b[33:41]
+	f.CKSNPS = b[41] & 0x07
+
+	return nil
+}
+
+// MarshalLen returns the serial length of MMContextForCSToPSSRVCCFields in int.
+func (f *MMContextForCSToPSSRVCCFields) MarshalLen() int {
+	return 42
+}
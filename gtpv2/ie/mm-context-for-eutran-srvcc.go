@@ -0,0 +1,108 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewMMContextForEUTRANSRVCC creates a new MMContextForEUTRANSRVCC IE.
+func NewMMContextForEUTRANSRVCC(eksi uint8, ckCS, ikCS, msClassmark2, msClassmark3, codecs []byte) *IE {
+	v := NewMMContextForEUTRANSRVCCFields(eksi, ckCS, ikCS, msClassmark2, msClassmark3, codecs)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MMContextForEUTRANSRVCC, 0x00, b)
+}
+
+// MMContextForEUTRANSRVCC returns MMContextForEUTRANSRVCC in
+// MMContextForEUTRANSRVCCFields type if the type of IE matches.
+func (i *IE) MMContextForEUTRANSRVCC() (*MMContextForEUTRANSRVCCFields, error) {
+	switch i.Type {
+	case MMContextForEUTRANSRVCC:
+		return ParseMMContextForEUTRANSRVCCFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MMContextForEUTRANSRVCCFields is a set of fields in MMContextForEUTRANSRVCC IE.
+type MMContextForEUTRANSRVCCFields struct {
+	EKSI               uint8  // 3-bit
+	CKCS               []byte // 16 octets
+	IKCS               []byte // 16 octets
+	MSClassmark2       []byte
+	MSClassmark3       []byte
+	SupportedCodecList []byte
+}
+
+// NewMMContextForEUTRANSRVCCFields creates a new MMContextForEUTRANSRVCCFields.
+func NewMMContextForEUTRANSRVCCFields(eksi uint8, ckCS, ikCS, msClassmark2, msClassmark3, codecs []byte) *MMContextForEUTRANSRVCCFields {
+	return &MMContextForEUTRANSRVCCFields{
+		EKSI:               eksi,
+		CKCS:               ckCS,
+		IKCS:               ikCS,
+		MSClassmark2:       msClassmark2,
+		MSClassmark3:       msClassmark3,
+		SupportedCodecList: codecs,
+	}
+}
+
+// Marshal serializes MMContextForEUTRANSRVCCFields.
+func (f *MMContextForEUTRANSRVCCFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes MMContextForEUTRANSRVCCFields.
+func (f *MMContextForEUTRANSRVCCFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+	if len(f.CKCS) > 16 || len(f.IKCS) > 16 {
+		return ErrMalformed
+	}
+
+	b[0] = f.EKSI & 0x07
+	copy(b[1:17], f.CKCS)
+	copy(b[17:33], f.IKCS)
+
+	_, err := marshalSRVCCClassmarks(b[33:], f.MSClassmark2, f.MSClassmark3, f.SupportedCodecList)
+	return err
+}
+
+// ParseMMContextForEUTRANSRVCCFields decodes MMContextForEUTRANSRVCCFields.
+func ParseMMContextForEUTRANSRVCCFields(b []byte) (*MMContextForEUTRANSRVCCFields, error) {
+	f := &MMContextForEUTRANSRVCCFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into MMContextForEUTRANSRVCCFields.
+func (f *MMContextForEUTRANSRVCCFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 33 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.EKSI = b[0] & 0x07
+	f.CKCS = b[1:17]
+	f.IKCS = b[17:33]
+
+	var err error
+	f.MSClassmark2, f.MSClassmark3, f.SupportedCodecList, _, err = parseSRVCCClassmarks(b[33:])
+	return err
+}
+
+// MarshalLen returns the serial length of MMContextForEUTRANSRVCCFields in int.
+func (f *MMContextForEUTRANSRVCCFields) MarshalLen() int {
+	return 33 + 3 + len(f.MSClassmark2) + len(f.MSClassmark3) + len(f.SupportedCodecList)
+}
@@ -0,0 +1,116 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewMMContextForUTRANSRVCC creates a new MMContextForUTRANSRVCC IE.
+func NewMMContextForUTRANSRVCC(ksiCS uint8, ckCS, ikCS, kc []byte, cksn uint8, msClassmark2, msClassmark3, codecs []byte) *IE {
+	v := NewMMContextForUTRANSRVCCFields(ksiCS, ckCS, ikCS, kc, cksn, msClassmark2, msClassmark3, codecs)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MMContextForUTRANSRVCC, 0x00, b)
+}
+
+// MMContextForUTRANSRVCC returns MMContextForUTRANSRVCC in
+// MMContextForUTRANSRVCCFields type if the type of IE matches.
+func (i *IE) MMContextForUTRANSRVCC() (*MMContextForUTRANSRVCCFields, error) {
+	switch i.Type {
+	case MMContextForUTRANSRVCC:
+		return ParseMMContextForUTRANSRVCCFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MMContextForUTRANSRVCCFields is a set of fields in MMContextForUTRANSRVCC IE.
+type MMContextForUTRANSRVCCFields struct {
+	KSICS              uint8  // 3-bit
+	CKCS               []byte // 16 octets
+	IKCS               []byte // 16 octets
+	Kc                 []byte // 8 octets
+	CKSN               uint8  // 3-bit
+	MSClassmark2       []byte
+	MSClassmark3       []byte
+	SupportedCodecList []byte
+}
+
+// NewMMContextForUTRANSRVCCFields creates a new MMContextForUTRANSRVCCFields.
+func NewMMContextForUTRANSRVCCFields(ksiCS uint8, ckCS, ikCS, kc []byte, cksn uint8, msClassmark2, msClassmark3, codecs []byte) *MMContextForUTRANSRVCCFields {
+	return &MMContextForUTRANSRVCCFields{
+		KSICS:              ksiCS,
+		CKCS:               ckCS,
+		IKCS:               ikCS,
+		Kc:                 kc,
+		CKSN:               cksn,
+		MSClassmark2:       msClassmark2,
+		MSClassmark3:       msClassmark3,
+		SupportedCodecList: codecs,
+	}
+}
+
+// Marshal serializes MMContextForUTRANSRVCCFields.
+func (f *MMContextForUTRANSRVCCFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes MMContextForUTRANSRVCCFields.
+func (f *MMContextForUTRANSRVCCFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+	if len(f.CKCS) > 16 || len(f.IKCS) > 16 || len(f.Kc) > 8 {
+		return ErrMalformed
+	}
+
+	b[0] = f.KSICS & 0x07
+	copy(b[1:17], f.CKCS)
+	copy(b[17:33], f.IKCS)
+	copy(b[33:41], f.Kc)
+	b[41] = f.CKSN & 0x07
+
+	_, err := marshalSRVCCClassmarks(b[42:], f.MSClassmark2, f.MSClassmark3, f.SupportedCodecList)
+	return err
+}
+
+// ParseMMContextForUTRANSRVCCFields decodes MMContextForUTRANSRVCCFields.
+func ParseMMContextForUTRANSRVCCFields(b []byte) (*MMContextForUTRANSRVCCFields, error) {
+	f := &MMContextForUTRANSRVCCFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into MMContextForUTRANSRVCCFields.
+func (f *MMContextForUTRANSRVCCFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 42 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.KSICS = b[0] & 0x07
+	f.CKCS = b[1:17]
+	f.IKCS = b[17:33]
+	f.Kc = b[33:41]
+	f.CKSN = b[41] & 0x07
+
+	var err error
+	f.MSClassmark2, f.MSClassmark3, f.SupportedCodecList, _, err = parseSRVCCClassmarks(b[42:])
+	return err
+}
+
+// MarshalLen returns the serial length of MMContextForUTRANSRVCCFields in int.
+func (f *MMContextForUTRANSRVCCFields) MarshalLen() int {
+	return 42 + 3 + len(f.MSClassmark2) + len(f.MSClassmark3) + len(f.SupportedCodecList)
+}
@@ -0,0 +1,678 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// Security Mode definitions.
+const (
+	securityModeGSMKeyAndTriplets uint8 = iota
+	securityModeUMTSKeyUsedCipherAndQuintuplets
+	securityModeGSMKeyUsedCipherAndQuintuplets
+	securityModeUMTSKeyAndQuintuplets
+	securityModeEPSSecurityContextAndQuadruplets
+	securityModeUMTSKeyQuadrupletsAndQuintuplets
+)
+
+// NewMMContextGSMKeyAndTriplets creates a new MMContextGSMKeyAndTriplets IE.
+func NewMMContextGSMKeyAndTriplets(cksn, usedCipher uint8, kc []byte, triplets ...*AuthenticationTriplet) *IE {
+	return NewMMContext(&MMContextFields{
+		SecurityMode:           securityModeGSMKeyAndTriplets,
+		KSI:                    cksn,
+		UsedCipher:             usedCipher,
+		Kc:                     kc,
+		AuthenticationTriplets: triplets,
+	})
+}
+
+// NewMMContextUMTSKeyUsedCipherAndQuintuplets creates a new MMContextUMTSKeyUsedCipherAndQuintuplets IE.
+func NewMMContextUMTSKeyUsedCipherAndQuintuplets(ksi, usedCipher uint8, ck, ik []byte, quintuplets ...*AuthenticationQuintuplet) *IE {
+	return NewMMContext(&MMContextFields{
+		SecurityMode:              securityModeUMTSKeyUsedCipherAndQuintuplets,
+		KSI:                       ksi,
+		UsedCipher:                usedCipher,
+		CK:                        ck,
+		IK:                        ik,
+		AuthenticationQuintuplets: quintuplets,
+	})
+}
+
+// NewMMContextGSMKeyUsedCipherAndQuintuplets creates a new MMContextGSMKeyUsedCipherAndQuintuplets IE.
+func NewMMContextGSMKeyUsedCipherAndQuintuplets(cksn, usedCipher uint8, kc []byte, quintuplets ...*AuthenticationQuintuplet) *IE {
+	return NewMMContext(&MMContextFields{
+		SecurityMode:              securityModeGSMKeyUsedCipherAndQuintuplets,
+		KSI:                       cksn,
+		UsedCipher:                usedCipher,
+		Kc:                        kc,
+		AuthenticationQuintuplets: quintuplets,
+	})
+}
+
+// NewMMContextUMTSKeyAndQuintuplets creates a new MMContextUMTSKeyAndQuintuplets IE.
+func NewMMContextUMTSKeyAndQuintuplets(ksi uint8, ck, ik []byte, quintuplets ...*AuthenticationQuintuplet) *IE {
+	return NewMMContext(&MMContextFields{
+		SecurityMode:              securityModeUMTSKeyAndQuintuplets,
+		KSI:                       ksi,
+		CK:                        ck,
+		IK:                        ik,
+		AuthenticationQuintuplets: quintuplets,
+	})
+}
+
+// NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets creates a new
+// MMContextEPSSecurityContextQuadrupletsAndQuintuplets IE.
+func NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets(
+	ksiAsme, nasIntegrity, nasCipher uint8, nasDownlinkCount, nasUplinkCount uint32, kasme []byte,
+	quadruplets []*AuthenticationQuadruplet, quintuplets []*AuthenticationQuintuplet, ueNetworkCapability []byte,
+) *IE {
+	return NewMMContext(&MMContextFields{
+		SecurityMode:              securityModeEPSSecurityContextAndQuadruplets,
+		KSI:                       ksiAsme,
+		UsedNASIntegrity:          nasIntegrity,
+		UsedCipher:                nasCipher,
+		NASDownlinkCount:          nasDownlinkCount,
+		NASUplinkCount:            nasUplinkCount,
+		Kasme:                     kasme,
+		AuthenticationQuadruplets: quadruplets,
+		AuthenticationQuintuplets: quintuplets,
+		UENetworkCapability:       ueNetworkCapability,
+	})
+}
+
+// NewMMContextUMTSKeyQuadrupletsAndQuintuplets creates a new MMContextUMTSKeyQuadrupletsAndQuintuplets IE.
+func NewMMContextUMTSKeyQuadrupletsAndQuintuplets(ksiAsme uint8, ck, ik []byte, quadruplets []*AuthenticationQuadruplet, quintuplets []*AuthenticationQuintuplet) *IE {
+	return NewMMContext(&MMContextFields{
+		SecurityMode:              securityModeUMTSKeyQuadrupletsAndQuintuplets,
+		KSI:                       ksiAsme,
+		CK:                        ck,
+		IK:                        ik,
+		AuthenticationQuadruplets: quadruplets,
+		AuthenticationQuintuplets: quintuplets,
+	})
+}
+
+// NewMMContext creates a new MM Context IE of the type that corresponds to the
+// SecurityMode in MMContextFields.
+//
+// This can be used when the optional fields like DRX parameter and UE AMBR are needed.
+func NewMMContext(f *MMContextFields) *IE {
+	if f.SecurityMode > securityModeUMTSKeyQuadrupletsAndQuintuplets {
+		return nil
+	}
+
+	b, err := f.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(MMContextGSMKeyAndTriplets+f.SecurityMode, 0x00, b)
+}
+
+// MMContext returns MMContext in MMContextFields type if the type of IE matches.
+func (i *IE) MMContext() (*MMContextFields, error) {
+	switch i.Type {
+	case MMContextGSMKeyAndTriplets,
+		MMContextUMTSKeyUsedCipherAndQuintuplets,
+		MMContextGSMKeyUsedCipherAndQuintuplets,
+		MMContextUMTSKeyAndQuintuplets,
+		MMContextEPSSecurityContextQuadrupletsAndQuintuplets,
+		MMContextUMTSKeyQuadrupletsAndQuintuplets:
+		return ParseMMContextFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustMMContext returns MMContext in *MMContextFields, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMMContext() *MMContextFields {
+	v, _ := i.MMContext()
+	return v
+}
+
+// MMContextFields is a set of fields in MM Context IEs.
+//
+// The fields that are not used in the type of IE specified by SecurityMode are ignored.
+// The optional fields are encoded only when the flag corresponding to each of them is set.
+type MMContextFields struct {
+	SecurityMode uint8 // 3-bit
+	NHI          bool  // EPS Security Context only
+	DRXI         bool
+	SAMBRI       bool
+	UAMBRI       bool
+	OSCI         bool // EPS Security Context only
+
+	// KSI is KSI, CKSN or KSI_ASME depending on SecurityMode.
+	KSI              uint8 // 3-bit
+	UsedCipher       uint8 // Used Cipher, or Used NAS Cipher in EPS Security Context
+	UsedNASIntegrity uint8 // EPS Security Context only
+	NASDownlinkCount uint32
+	NASUplinkCount   uint32
+
+	Kasme []byte // 32 octets
+	Kc    []byte // 8 octets
+	CK    []byte // 16 octets
+	IK    []byte // 16 octets
+
+	AuthenticationTriplets    []*AuthenticationTriplet
+	AuthenticationQuintuplets []*AuthenticationQuintuplet
+	AuthenticationQuadruplets []*AuthenticationQuadruplet
+
+	DRXParameter             []byte // 2 octets, present if DRXI is set
+	NH                       []byte // 32 octets, present if NHI is set
+	NCC                      uint8  // present if NHI is set
+	UplinkSubscribedUEAMBR   uint32 // present if SAMBRI is set
+	DownlinkSubscribedUEAMBR uint32 // present if SAMBRI is set
+	UplinkUsedUEAMBR         uint32 // present if UAMBRI is set
+	DownlinkUsedUEAMBR       uint32 // present if UAMBRI is set
+
+	UENetworkCapability     []byte
+	MSNetworkCapability     []byte
+	MobileEquipmentIdentity []byte
+
+	// AdditionalFields are the octets after Mobile Equipment Identity (e.g., the old
+	// EPS security context and Voice Domain Preference), which are kept as they are.
+	AdditionalFields []byte
+}
+
+// Marshal serializes MMContextFields.
+func (f *MMContextFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes MMContextFields.
+func (f *MMContextFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	isEPS := f.SecurityMode == securityModeEPSSecurityContextAndQuadruplets
+	b[0] = (f.SecurityMode&0x07)<<5 | f.KSI&0x07
+	if isEPS && f.NHI {
+		b[0] |= 0x10
+	}
+	if f.DRXI {
+		b[0] |= 0x08
+	}
+
+	switch f.SecurityMode {
+	case securityModeGSMKeyAndTriplets:
+		b[1] = uint8(len(f.AuthenticationTriplets)&0x07) << 5
+	case securityModeEPSSecurityContextAndQuadruplets, securityModeUMTSKeyQuadrupletsAndQuintuplets:
+		b[1] = uint8(len(f.AuthenticationQuintuplets)&0x07)<<5 | uint8(len(f.AuthenticationQuadruplets)&0x07)<<2
+	default:
+		b[1] = uint8(len(f.AuthenticationQuintuplets)&0x07) << 5
+	}
+	if f.UAMBRI {
+		b[1] |= 0x02
+	}
+	if isEPS {
+		if f.OSCI {
+			b[1] |= 0x01
+		}
+	} else if f.SAMBRI {
+		b[1] |= 0x01
+	}
+
+	switch f.SecurityMode {
+	case securityModeGSMKeyAndTriplets, securityModeUMTSKeyUsedCipherAndQuintuplets, securityModeGSMKeyUsedCipherAndQuintuplets:
+		b[2] = f.UsedCipher & 0x07
+	case securityModeEPSSecurityContextAndQuadruplets:
+		b[2] = (f.UsedNASIntegrity&0x07)<<4 | f.UsedCipher&0x0f
+		if f.SAMBRI {
+			b[2] |= 0x80
+		}
+	}
+	offset := 3
+
+	switch f.SecurityMode {
+	case securityModeGSMKeyAndTriplets, securityModeGSMKeyUsedCipherAndQuintuplets:
+		copy(b[offset:offset+8], f.Kc)
+		offset += 8
+	case securityModeEPSSecurityContextAndQuadruplets:
+		copy(b[offset:offset+3], utils.Uint32To24(f.NASDownlinkCount))
+		copy(b[offset+3:offset+6], utils.Uint32To24(f.NASUplinkCount))
+		copy(b[offset+6:offset+38], f.Kasme)
+		offset += 38
+	default:
+		copy(b[offset:offset+16], f.CK)
+		copy(b[offset+16:offset+32], f.IK)
+		offset += 32
+	}
+
+	if f.SecurityMode == securityModeGSMKeyAndTriplets {
+		for _, v := range f.AuthenticationTriplets {
+			if err := v.MarshalTo(b[offset:]); err != nil {
+				return err
+			}
+			offset += v.MarshalLen()
+		}
+	}
+	if f.SecurityMode == securityModeEPSSecurityContextAndQuadruplets || f.SecurityMode == securityModeUMTSKeyQuadrupletsAndQuintuplets {
+		for _, v := range f.AuthenticationQuadruplets {
+			if err := v.MarshalTo(b[offset:]); err != nil {
+				return err
+			}
+			offset += v.MarshalLen()
+		}
+	}
+	if f.SecurityMode != securityModeGSMKeyAndTriplets {
+		for _, v := range f.AuthenticationQuintuplets {
+			if err := v.MarshalTo(b[offset:]); err != nil {
+				return err
+			}
+			offset += v.MarshalLen()
+		}
+	}
+
+	if f.DRXI {
+		copy(b[offset:offset+2], f.DRXParameter)
+		offset += 2
+	}
+	if isEPS && f.NHI {
+		copy(b[offset:offset+32], f.NH)
+		b[offset+32] = f.NCC & 0x07
+		offset += 33
+	}
+	if f.SAMBRI {
+		binary.BigEndian.PutUint32(b[offset:offset+4], f.UplinkSubscribedUEAMBR)
+		binary.BigEndian.PutUint32(b[offset+4:offset+8], f.DownlinkSubscribedUEAMBR)
+		offset += 8
+	}
+	if f.UAMBRI {
+		binary.BigEndian.PutUint32(b[offset:offset+4], f.UplinkUsedUEAMBR)
+		binary.BigEndian.PutUint32(b[offset+4:offset+8], f.DownlinkUsedUEAMBR)
+		offset += 8
+	}
+
+	for _, v := range [][]byte{f.UENetworkCapability, f.MSNetworkCapability, f.MobileEquipmentIdentity} {
+		b[offset] = uint8(len(v))
+		copy(b[offset+1:offset+1+len(v)], v)
+		offset += 1 + len(v)
+	}
+
+	copy(b[offset:], f.AdditionalFields)
+	return nil
+}
+
+// ParseMMContextFields decodes MMContextFields.
+func ParseMMContextFields(b []byte) (*MMContextFields, error) {
+	f := &MMContextFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into MMContextFields.
+func (f *MMContextFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 3 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.SecurityMode = b[0] >> 5
+	f.DRXI = has4thBit(b[0])
+	f.KSI = b[0] & 0x07
+	f.UAMBRI = has2ndBit(b[1])
+
+	isEPS := f.SecurityMode == securityModeEPSSecurityContextAndQuadruplets
+	var nTriplets, nQuintuplets, nQuadruplets int
+	switch f.SecurityMode {
+	case securityModeGSMKeyAndTriplets:
+		nTriplets = int(b[1] >> 5)
+	case securityModeEPSSecurityContextAndQuadruplets, securityModeUMTSKeyQuadrupletsAndQuintuplets:
+		nQuintuplets = int(b[1] >> 5)
+		nQuadruplets = int(b[1]>>2) & 0x07
+	default:
+		nQuintuplets = int(b[1] >> 5)
+	}
+	if isEPS {
+		f.NHI = has5thBit(b[0])
+		f.OSCI = has1stBit(b[1])
+		f.SAMBRI = has8thBit(b[2])
+		f.UsedNASIntegrity = (b[2] >> 4) & 0x07
+		f.UsedCipher = b[2] & 0x0f
+	} else {
+		f.SAMBRI = has1stBit(b[1])
+		f.UsedCipher = b[2] & 0x07
+	}
+	offset := 3
+
+	switch f.SecurityMode {
+	case securityModeGSMKeyAndTriplets, securityModeGSMKeyUsedCipherAndQuintuplets:
+		if l < offset+8 {
+			return io.ErrUnexpectedEOF
+		}
+		f.Kc = b[offset : offset+8]
+		offset += 8
+	case securityModeEPSSecurityContextAndQuadruplets:
+		if l < offset+38 {
+			return io.ErrUnexpectedEOF
+		}
+		f.NASDownlinkCount = utils.Uint24To32(b[offset : offset+3])
+		f.NASUplinkCount = utils.Uint24To32(b[offset+3 : offset+6])
+		f.Kasme = b[offset+6 : offset+38]
+		offset += 38
+	default:
+		if l < offset+32 {
+			return io.ErrUnexpectedEOF
+		}
+		f.CK = b[offset : offset+16]
+		f.IK = b[offset+16 : offset+32]
+		offset += 32
+	}
+
+	for n := 0; n < nTriplets; n++ {
+		v, err := ParseAuthenticationTriplet(b[offset:])
+		if err != nil {
+			return err
+		}
+		f.AuthenticationTriplets = append(f.AuthenticationTriplets, v)
+		offset += v.MarshalLen()
+	}
+	for n := 0; n < nQuadruplets; n++ {
+		v, err := ParseAuthenticationQuadruplet(b[offset:])
+		if err != nil {
+			return err
+		}
+		f.AuthenticationQuadruplets = append(f.AuthenticationQuadruplets, v)
+		offset += v.MarshalLen()
+	}
+	for n := 0; n < nQuintuplets; n++ {
+		v, err := ParseAuthenticationQuintuplet(b[offset:])
+		if err != nil {
+			return err
+		}
+		f.AuthenticationQuintuplets = append(f.AuthenticationQuintuplets, v)
+		offset += v.MarshalLen()
+	}
+
+	if f.DRXI {
+		if l < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		f.DRXParameter = b[offset : offset+2]
+		offset += 2
+	}
+	if isEPS && f.NHI {
+		if l < offset+33 {
+			return io.ErrUnexpectedEOF
+		}
+		f.NH = b[offset : offset+32]
+		f.NCC = b[offset+32] & 0x07
+		offset += 33
+	}
+	if f.SAMBRI {
+		if l < offset+8 {
+			return io.ErrUnexpectedEOF
+		}
+		f.UplinkSubscribedUEAMBR = binary.BigEndian.Uint32(b[offset : offset+4])
+		f.DownlinkSubscribedUEAMBR = binary.BigEndian.Uint32(b[offset+4 : offset+8])
+		offset += 8
+	}
+	if f.UAMBRI {
+		if l < offset+8 {
+			return io.ErrUnexpectedEOF
+		}
+		f.UplinkUsedUEAMBR = binary.BigEndian.Uint32(b[offset : offset+4])
+		f.DownlinkUsedUEAMBR = binary.BigEndian.Uint32(b[offset+4 : offset+8])
+		offset += 8
+	}
+
+	// the fields below may be absent in the IE sent by the older implementations.
+	for _, v := range []*[]byte{&f.UENetworkCapability, &f.MSNetworkCapability, &f.MobileEquipmentIdentity} {
+		if l <= offset {
+			return nil
+		}
+		n := int(b[offset])
+		if l < offset+1+n {
+			return io.ErrUnexpectedEOF
+		}
+		*v = b[offset+1 : offset+1+n]
+		offset += 1 + n
+	}
+
+	if l > offset {
+		f.AdditionalFields = b[offset:]
+	}
+	return nil
+}
+
+// MarshalLen returns the serial length of MMContextFields in int.
+func (f *MMContextFields) MarshalLen() int {
+	l := 3
+
+	switch f.SecurityMode {
+	case securityModeGSMKeyAndTriplets:
+		l += 8
+		for _, v := range f.AuthenticationTriplets {
+			l += v.MarshalLen()
+		}
+	case securityModeGSMKeyUsedCipherAndQuintuplets:
+		l += 8
+	case securityModeEPSSecurityContextAndQuadruplets:
+		l += 38
+		if f.NHI {
+			l += 33
+		}
+	default:
+		l += 32
+	}
+
+	if f.SecurityMode == securityModeEPSSecurityContextAndQuadruplets || f.SecurityMode == securityModeUMTSKeyQuadrupletsAndQuintuplets {
+		for _, v := range f.AuthenticationQuadruplets {
+			l += v.MarshalLen()
+		}
+	}
+	if f.SecurityMode != securityModeGSMKeyAndTriplets {
+		for _, v := range f.AuthenticationQuintuplets {
+			l += v.MarshalLen()
+		}
+	}
+
+	if f.DRXI {
+		l += 2
+	}
+	if f.SAMBRI {
+		l += 8
+	}
+	if f.UAMBRI {
+		l += 8
+	}
+
+	l += 3 + len(f.UENetworkCapability) + len(f.MSNetworkCapability) + len(f.MobileEquipmentIdentity)
+	return l + len(f.AdditionalFields)
+}
+
+// AuthenticationTriplet represents an Authentication Triplet, which is defined
+// to be used as a field of MM Context IE.
+type AuthenticationTriplet struct {
+	RAND []byte // 16 octets
+	SRES []byte // 4 octets
+	Kc   []byte // 8 octets
+}
+
+// NewAuthenticationTriplet creates a new AuthenticationTriplet.
+func NewAuthenticationTriplet(rand, sres, kc []byte) *AuthenticationTriplet {
+	return &AuthenticationTriplet{
+		RAND: rand,
+		SRES: sres,
+		Kc:   kc,
+	}
+}
+
+// MarshalTo serializes AuthenticationTriplet.
+func (a *AuthenticationTriplet) MarshalTo(b []byte) error {
+	if len(b) < a.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b[0:16], a.RAND)
+	copy(b[16:20], a.SRES)
+	copy(b[20:28], a.Kc)
+	return nil
+}
+
+// ParseAuthenticationTriplet decodes AuthenticationTriplet.
+func ParseAuthenticationTriplet(b []byte) (*AuthenticationTriplet, error) {
+	if len(b) < 28 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return &AuthenticationTriplet{
+		RAND: b[0:16],
+		SRES: b[16:20],
+		Kc:   b[20:28],
+	}, nil
+}
+
+// MarshalLen returns the serial length of AuthenticationTriplet in int.
+func (a *AuthenticationTriplet) MarshalLen() int {
+	return 28
+}
+
+// AuthenticationQuintuplet represents an Authentication Quintuplet, which is defined
+// to be used as a field of MM Context IE.
+type AuthenticationQuintuplet struct {
+	RAND []byte // 16 octets
+	XRES []byte
+	CK   []byte // 16 octets
+	IK   []byte // 16 octets
+	AUTN []byte
+}
+
+// NewAuthenticationQuintuplet creates a new AuthenticationQuintuplet.
+func NewAuthenticationQuintuplet(rand, xres, ck, ik, autn []byte) *AuthenticationQuintuplet {
+	return &AuthenticationQuintuplet{
+		RAND: rand,
+		XRES: xres,
+		CK:   ck,
+		IK:   ik,
+		AUTN: autn,
+	}
+}
+
+// MarshalTo serializes AuthenticationQuintuplet.
+func (a *AuthenticationQuintuplet) MarshalTo(b []byte) error {
+	if len(b) < a.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b[0:16], a.RAND)
+	b[16] = uint8(len(a.XRES))
+	offset := 17 + copy(b[17:], a.XRES)
+	copy(b[offset:offset+16], a.CK)
+	copy(b[offset+16:offset+32], a.IK)
+	b[offset+32] = uint8(len(a.AUTN))
+	copy(b[offset+33:], a.AUTN)
+	return nil
+}
+
+// ParseAuthenticationQuintuplet decodes AuthenticationQuintuplet.
+func ParseAuthenticationQuintuplet(b []byte) (*AuthenticationQuintuplet, error) {
+	l := len(b)
+	if l < 17 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	a := &AuthenticationQuintuplet{RAND: b[0:16]}
+	offset := 17 + int(b[16])
+	if l < offset+33 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	a.XRES = b[17:offset]
+	a.CK = b[offset : offset+16]
+	a.IK = b[offset+16 : offset+32]
+
+	n := int(b[offset+32])
+	offset += 33
+	if l < offset+n {
+		return nil, io.ErrUnexpectedEOF
+	}
+	a.AUTN = b[offset : offset+n]
+
+	return a, nil
+}
+
+// MarshalLen returns the serial length of AuthenticationQuintuplet in int.
+func (a *AuthenticationQuintuplet) MarshalLen() int {
+	return 50 + len(a.XRES) + len(a.AUTN)
+}
+
+// AuthenticationQuadruplet represents an Authentication Quadruplet, which is defined
+// to be used as a field of MM Context IE.
+type AuthenticationQuadruplet struct {
+	RAND  []byte // 16 octets
+	XRES  []byte
+	AUTN  []byte
+	Kasme []byte // 32 octets
+}
+
+// NewAuthenticationQuadruplet creates a new AuthenticationQuadruplet.
+func NewAuthenticationQuadruplet(rand, xres, autn, kasme []byte) *AuthenticationQuadruplet {
+	return &AuthenticationQuadruplet{
+		RAND:  rand,
+		XRES:  xres,
+		AUTN:  autn,
+		Kasme: kasme,
+	}
+}
+
+// MarshalTo serializes AuthenticationQuadruplet.
+func (a *AuthenticationQuadruplet) MarshalTo(b []byte) error {
+	if len(b) < a.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b[0:16], a.RAND)
+	b[16] = uint8(len(a.XRES))
+	offset := 17 + copy(b[17:], a.XRES)
+	b[offset] = uint8(len(a.AUTN))
+	offset += 1 + copy(b[offset+1:], a.AUTN)
+	copy(b[offset:offset+32], a.Kasme)
+	return nil
+}
+
+// ParseAuthenticationQuadruplet decodes AuthenticationQuadruplet.
+func ParseAuthenticationQuadruplet(b []byte) (*AuthenticationQuadruplet, error) {
+	l := len(b)
+	if l < 17 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	a := &AuthenticationQuadruplet{RAND: b[0:16]}
+	offset := 17 + int(b[16])
+	if l < offset+1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	a.XRES = b[17:offset]
+
+	n := int(b[offset])
+	offset++
+	if l < offset+n+32 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	a.AUTN = b[offset : offset+n]
+	a.Kasme = b[offset+n : offset+n+32]
+
+	return a, nil
+}
+
+// MarshalLen returns the serial length of AuthenticationQuadruplet in int.
+func (a *AuthenticationQuadruplet) MarshalLen() int {
+	return 50 + len(a.XRES) + len(a.AUTN)
+}
@@ -0,0 +1,148 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestMMContext(t *testing.T) {
+	cases := []struct {
+		description string
+		fields      *ie.MMContextFields
+		ieType      uint8
+	}{
+		{
+			"GSMKeyAndTriplets",
+			&ie.MMContextFields{
+				SecurityMode: gtpv2.SecurityModeGSMKeyAndTriplets,
+				DRXI:         true,
+				SAMBRI:       true,
+				KSI:          2,
+				UsedCipher:   1,
+				Kc:           bytes.Repeat([]byte{0x01}, 8),
+				AuthenticationTriplets: []*ie.AuthenticationTriplet{
+					ie.NewAuthenticationTriplet(bytes.Repeat([]byte{0x02}, 16), bytes.Repeat([]byte{0x03}, 4), bytes.Repeat([]byte{0x04}, 8)),
+					ie.NewAuthenticationTriplet(bytes.Repeat([]byte{0x05}, 16), bytes.Repeat([]byte{0x06}, 4), bytes.Repeat([]byte{0x07}, 8)),
+				},
+				DRXParameter:             []byte{0x0a, 0x00},
+				UplinkSubscribedUEAMBR:   100000,
+				DownlinkSubscribedUEAMBR: 200000,
+				MSNetworkCapability:      []byte{0xe5, 0xe0, 0x34},
+			},
+			ie.MMContextGSMKeyAndTriplets,
+		}, {
+			"UMTSKeyUsedCipherAndQuintuplets",
+			&ie.MMContextFields{
+				SecurityMode: gtpv2.SecurityModeUMTSKeyUsedCipherAndQuintuplets,
+				UAMBRI:       true,
+				KSI:          3,
+				UsedCipher:   2,
+				CK:           bytes.Repeat([]byte{0x01}, 16),
+				IK:           bytes.Repeat([]byte{0x02}, 16),
+				AuthenticationQuintuplets: []*ie.AuthenticationQuintuplet{
+					ie.NewAuthenticationQuintuplet(
+						bytes.Repeat([]byte{0x03}, 16), bytes.Repeat([]byte{0x04}, 8),
+						bytes.Repeat([]byte{0x05}, 16), bytes.Repeat([]byte{0x06}, 16), bytes.Repeat([]byte{0x07}, 16),
+					),
+				},
+				UplinkUsedUEAMBR:        300000,
+				DownlinkUsedUEAMBR:      400000,
+				MobileEquipmentIdentity: []byte{0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9},
+			},
+			ie.MMContextUMTSKeyUsedCipherAndQuintuplets,
+		}, {
+			"EPSSecurityContextAndQuadruplets",
+			&ie.MMContextFields{
+				SecurityMode:     gtpv2.SecurityModeEPSSecurityContextAndQuadruplets,
+				NHI:              true,
+				DRXI:             true,
+				SAMBRI:           true,
+				UAMBRI:           true,
+				KSI:              1,
+				UsedCipher:       2,
+				UsedNASIntegrity: 2,
+				NASDownlinkCount: 0x123456,
+				NASUplinkCount:   0x654321,
+				Kasme:            bytes.Repeat([]byte{0x01}, 32),
+				AuthenticationQuadruplets: []*ie.AuthenticationQuadruplet{
+					ie.NewAuthenticationQuadruplet(
+						bytes.Repeat([]byte{0x02}, 16), bytes.Repeat([]byte{0x03}, 8),
+						bytes.Repeat([]byte{0x04}, 16), bytes.Repeat([]byte{0x05}, 32),
+					),
+				},
+				AuthenticationQuintuplets: []*ie.AuthenticationQuintuplet{
+					ie.NewAuthenticationQuintuplet(
+						bytes.Repeat([]byte{0x06}, 16), bytes.Repeat([]byte{0x07}, 4),
+						bytes.Repeat([]byte{0x08}, 16), bytes.Repeat([]byte{0x09}, 16), bytes.Repeat([]byte{0x0a}, 16),
+					),
+				},
+				DRXParameter:             []byte{0x0a, 0x00},
+				NH:                       bytes.Repeat([]byte{0x0b}, 32),
+				NCC:                      3,
+				UplinkSubscribedUEAMBR:   100000,
+				DownlinkSubscribedUEAMBR: 200000,
+				UplinkUsedUEAMBR:         300000,
+				DownlinkUsedUEAMBR:       400000,
+				UENetworkCapability:      []byte{0xe0, 0xe0},
+				AdditionalFields:         []byte{0x00, 0x01, 0x02},
+			},
+			ie.MMContextEPSSecurityContextQuadrupletsAndQuintuplets,
+		}, {
+			"UMTSKeyQuadrupletsAndQuintuplets",
+			&ie.MMContextFields{
+				SecurityMode: gtpv2.SecurityModeUMTSKeyQuadrupletsAndQuintuplets,
+				KSI:          4,
+				CK:           bytes.Repeat([]byte{0x01}, 16),
+				IK:           bytes.Repeat([]byte{0x02}, 16),
+				AuthenticationQuadruplets: []*ie.AuthenticationQuadruplet{
+					ie.NewAuthenticationQuadruplet(
+						bytes.Repeat([]byte{0x03}, 16), bytes.Repeat([]byte{0x04}, 8),
+						bytes.Repeat([]byte{0x05}, 16), bytes.Repeat([]byte{0x06}, 32),
+					),
+				},
+			},
+			ie.MMContextUMTSKeyQuadrupletsAndQuintuplets,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			i := ie.NewMMContext(c.fields)
+			if i.Type != c.ieType {
+				t.Fatalf("unexpected type: got %d, want %d", i.Type, c.ieType)
+			}
+
+			b, err := i.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := ie.Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := parsed.MMContext()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.fields, cmpopts.EquateEmpty()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+
+	t.Run("InvalidType", func(t *testing.T) {
+		if _, err := ie.NewRecovery(1).MMContext(); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,54 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"time"
+)
+
+// NewOverloadControlInformation creates a new OverloadControlInformation IE.
+//
+// The APNs given are put as the List of Access Point Name, which means the overload
+// applies only to those APNs. Without any APN, the overload applies to the node.
+func NewOverloadControlInformation(seq uint32, metric uint8, validity time.Duration, apns ...string) *IE {
+	ies := []*IE{
+		NewSequenceNumber(seq),
+		NewMetric(metric),
+		NewEPCTimer(validity),
+	}
+	for _, apn := range apns {
+		ies = append(ies, NewAccessPointName(apn))
+	}
+	return newGroupedIE(OverloadControlInformation, ies...)
+}
+
+// OverloadControlInformation returns the IEs above OverloadControlInformation if the type of IE matches.
+func (i *IE) OverloadControlInformation() ([]*IE, error) {
+	if i.Type != OverloadControlInformation {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return ParseMultiIEs(i.Payload)
+}
+
+// PeriodOfValidity returns the Period of Validity in OverloadControlInformation
+// in time.Duration if the type of IE matches.
+func (i *IE) PeriodOfValidity() (time.Duration, error) {
+	if i.Type != OverloadControlInformation {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	return i.Timer()
+}
+
+// MustPeriodOfValidity returns PeriodOfValidity in time.Duration, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustPeriodOfValidity() time.Duration {
+	v, _ := i.PeriodOfValidity()
+	return v
+}
@@ -0,0 +1,67 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestOverloadControlInformation(t *testing.T) {
+	oci := ie.NewOverloadControlInformation(1, 50, 10*time.Minute, "some.apn")
+
+	if got := oci.MustSequenceNumber(); got != 1 {
+		t.Errorf("unexpected SequenceNumber: got %d, want 1", got)
+	}
+	if got := oci.MustMetric(); got != 50 {
+		t.Errorf("unexpected Metric: got %d, want 50", got)
+	}
+	if got := oci.MustPeriodOfValidity(); got != 10*time.Minute {
+		t.Errorf("unexpected PeriodOfValidity: got %s, want %s", got, 10*time.Minute)
+	}
+
+	ies, err := oci.OverloadControlInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var apns []string
+	for _, i := range ies {
+		if i.Type == ie.AccessPointName {
+			apns = append(apns, i.MustAccessPointName())
+		}
+	}
+	if len(apns) != 1 || apns[0] != "some.apn" {
+		t.Errorf("unexpected APNs: %v", apns)
+	}
+}
+
+func TestLoadControlInformation(t *testing.T) {
+	lci := ie.NewLoadControlInformation(
+		2, 80, ie.NewAPNAndRelativeCapacity(30, "foo.apn"), ie.NewAPNAndRelativeCapacity(70, "bar.apn"),
+	)
+
+	if got := lci.MustSequenceNumber(); got != 2 {
+		t.Errorf("unexpected SequenceNumber: got %d, want 2", got)
+	}
+	if got := lci.MustMetric(); got != 80 {
+		t.Errorf("unexpected Metric: got %d, want 80", got)
+	}
+
+	ies, err := lci.LoadControlInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	capacities := map[string]uint8{}
+	for _, i := range ies {
+		if i.Type == ie.APNAndRelativeCapacity {
+			capacities[i.MustAccessPointName()] = i.MustRelativeCapacity()
+		}
+	}
+	if capacities["foo.apn"] != 30 || capacities["bar.apn"] != 70 {
+		t.Errorf("unexpected APN and Relative Capacity: %v", capacities)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewPC5QoSFlow creates a new PC5QoSFlow IE.
+//
+// The bit rates are in kbps. Range is included only when it is not zero.
+func NewPC5QoSFlow(pqi uint8, gfbr, mfbr uint32, rng uint8) *IE {
+	fields := NewPC5QoSFlowFields(pqi, gfbr, mfbr, rng)
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(PC5QoSFlow, 0x00, b)
+}
+
+// PC5QoSFlow returns PC5QoSFlow in PC5QoSFlowFields type if the type of IE matches.
+func (i *IE) PC5QoSFlow() (*PC5QoSFlowFields, error) {
+	switch i.Type {
+	case PC5QoSFlow:
+		return ParsePC5QoSFlowFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// PC5QoSFlowFields is a set of fields in PC5QoSFlow IE.
+type PC5QoSFlowFields struct {
+	PQI                   uint8
+	GuaranteedFlowBitRate uint32
+	MaximumFlowBitRate    uint32
+	Flags                 uint8
+	Range                 uint8
+}
+
+// NewPC5QoSFlowFields creates a new PC5QoSFlowFields.
+func NewPC5QoSFlowFields(pqi uint8, gfbr, mfbr uint32, rng uint8) *PC5QoSFlowFields {
+	f := &PC5QoSFlowFields{
+		PQI:                   pqi,
+		GuaranteedFlowBitRate: gfbr,
+		MaximumFlowBitRate:    mfbr,
+	}
+	if rng != 0 {
+		f.Flags |= 0x01
+		f.Range = rng
+	}
+
+	return f
+}
+
+// HasRange reports whether Range is present in PC5QoSFlowFields.
+func (f *PC5QoSFlowFields) HasRange() bool {
+	return has1stBit(f.Flags)
+}
+
+// Marshal serializes PC5QoSFlowFields.
+func (f *PC5QoSFlowFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes PC5QoSFlowFields.
+func (f *PC5QoSFlowFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.PQI
+	binary.BigEndian.PutUint32(b[1:5], f.GuaranteedFlowBitRate)
+	binary.BigEndian.PutUint32(b[5:9], f.MaximumFlowBitRate)
+	b[9] = f.Flags & 0x01
+	if f.HasRange() {
+		b[10] = f.Range
+	}
+
+	return nil
+}
+
+// ParsePC5QoSFlowFields decodes PC5QoSFlowFields.
+func ParsePC5QoSFlowFields(b []byte) (*PC5QoSFlowFields, error) {
+	f := &PC5QoSFlowFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into PC5QoSFlowFields.
+func (f *PC5QoSFlowFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 10 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.PQI = b[0]
+	f.GuaranteedFlowBitRate = binary.BigEndian.Uint32(b[1:5])
+	f.MaximumFlowBitRate = binary.BigEndian.Uint32(b[5:9])
+	f.Flags = b[9] & 0x01
+	if f.HasRange() {
+		if len(b) < 11 {
+			return io.ErrUnexpectedEOF
+		}
+		f.Range = b[10]
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of PC5QoSFlowFields in int.
+func (f *PC5QoSFlowFields) MarshalLen() int {
+	if f.HasRange() {
+		return 11
+	}
+	return 10
+}
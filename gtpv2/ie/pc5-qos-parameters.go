@@ -0,0 +1,40 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewPC5QoSParameters creates a new PC5QoSParameters IE.
+//
+// flows should be PC5QoSFlow IEs, and linkAggregatedBitRates should be a BitRate IE
+// or nil.
+func NewPC5QoSParameters(linkAggregatedBitRates *IE, flows ...*IE) *IE {
+	var ies []*IE
+	for _, i := range append(flows, linkAggregatedBitRates) {
+		if i != nil {
+			ies = append(ies, i)
+		}
+	}
+	return newGroupedIE(PC5QoSParameters, ies...)
+}
+
+// PC5QoSParameters returns the IEs above PC5QoSParameters if the type of IE matches.
+func (i *IE) PC5QoSParameters() ([]*IE, error) {
+	if i.Type != PC5QoSParameters {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return ParseMultiIEs(i.Payload)
+}
+
+// MustPC5QoSParameters returns PC5QoSParameters in []*IE, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustPC5QoSParameters() []*IE {
+	v, _ := i.PC5QoSParameters()
+	return v
+}
@@ -0,0 +1,44 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewPDNConnection creates a new PDNConnection IE.
+func NewPDNConnection(ies ...*IE) *IE {
+	var omitted []*IE
+	for _, ie := range ies {
+		if ie != nil {
+			omitted = append(omitted, ie)
+		}
+	}
+	return newGroupedIE(PDNConnection, omitted...)
+}
+
+// NewPDNConnectionWithinForwardRelocationRequest creates a new PDNConnection used within
+// ForwardRelocationRequest and ContextResponse.
+func NewPDNConnectionWithinForwardRelocationRequest(apn, apnRestriction, selectionMode, ipv4, ipv6, linkedEBI, pgwFTEID, pgwNodeName, ambr, chargingChars, changeReportingAction *IE, bearerContexts ...*IE) *IE {
+	ies := []*IE{apn, apnRestriction, selectionMode, ipv4, ipv6, linkedEBI, pgwFTEID, pgwNodeName}
+	ies = append(ies, bearerContexts...)
+	ies = append(ies, ambr, chargingChars, changeReportingAction)
+	return NewPDNConnection(ies...)
+}
+
+// PDNConnection returns the []*IE inside PDNConnection IE.
+func (i *IE) PDNConnection() ([]*IE, error) {
+	if i.Type != PDNConnection {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	ies, err := ParseMultiIEs(i.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return ies, nil
+}
@@ -0,0 +1,335 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+const henbilen int = 7
+
+// HENBI represents a Home eNodeB ID, which is defined to be used as a field of
+// PresenceReportingAreaAction IE.
+type HENBI struct {
+	*PLMN
+	HENBI uint32
+}
+
+// NewHENBI creates a new HENBI.
+func NewHENBI(mcc, mnc string, henbi uint32) *HENBI {
+	return &HENBI{
+		PLMN:  &PLMN{MCC: mcc, MNC: mnc},
+		HENBI: henbi & 0xfffffff,
+	}
+}
+
+// NewPresenceReportingAreaAction creates a new PresenceReportingAreaAction IE.
+//
+// The list of the elements that compose the Presence Reporting Area should be set
+// in the fields given. Use NewPresenceReportingAreaActionFields to create it with
+// no elements, which is enough to stop reporting.
+func NewPresenceReportingAreaAction(fields *PresenceReportingAreaActionFields) *IE {
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(PresenceReportingAreaAction, 0x00, b)
+}
+
+// PresenceReportingAreaAction returns PresenceReportingAreaAction in
+// PresenceReportingAreaActionFields type if the type of IE matches.
+func (i *IE) PresenceReportingAreaAction() (*PresenceReportingAreaActionFields, error) {
+	switch i.Type {
+	case PresenceReportingAreaAction:
+		return ParsePresenceReportingAreaActionFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// PresenceReportingAreaActionFields is a set of fields in PresenceReportingAreaAction IE.
+type PresenceReportingAreaActionFields struct {
+	INAPRA              bool
+	Action              uint8  // 3-bit
+	PRAID               uint32 // 24-bit
+	TAIs                []*TAI
+	MacroENBIDs         []*MENBI
+	HomeENBIDs          []*HENBI
+	ECGIs               []*ECGI
+	RAIs                []*RAI
+	SAIs                []*SAI
+	CGIs                []*CGI
+	ExtendedMacroENBIDs []*EMENBI
+}
+
+// NewPresenceReportingAreaActionFields creates a new PresenceReportingAreaActionFields.
+func NewPresenceReportingAreaActionFields(action uint8, praID uint32) *PresenceReportingAreaActionFields {
+	return &PresenceReportingAreaActionFields{
+		Action: action & 0x07,
+		PRAID:  praID & 0xffffff,
+	}
+}
+
+// Marshal serializes PresenceReportingAreaActionFields.
+func (f *PresenceReportingAreaActionFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes PresenceReportingAreaActionFields.
+func (f *PresenceReportingAreaActionFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+	if len(f.TAIs) > 0x0f || len(f.RAIs) > 0x0f {
+		return ErrMalformed
+	}
+	for _, n := range []int{
+		len(f.MacroENBIDs), len(f.HomeENBIDs), len(f.ECGIs),
+		len(f.SAIs), len(f.CGIs), len(f.ExtendedMacroENBIDs),
+	} {
+		if n > 0x3f {
+			return ErrMalformed
+		}
+	}
+
+	b[0] = f.Action & 0x07
+	if f.INAPRA {
+		b[0] |= 0x08
+	}
+	copy(b[1:4], utils.Uint32To24(f.PRAID))
+	b[4] = uint8(len(f.TAIs))<<4 | uint8(len(f.RAIs))
+	b[5] = uint8(len(f.MacroENBIDs))
+	b[6] = uint8(len(f.HomeENBIDs))
+	b[7] = uint8(len(f.ECGIs))
+	b[8] = uint8(len(f.SAIs))
+	b[9] = uint8(len(f.CGIs))
+	offset := 10
+
+	for _, v := range f.TAIs {
+		if err := putPLMN(b[offset:], v.PLMN); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint16(b[offset+3:offset+5], v.TAC)
+		offset += tailen
+	}
+	for _, v := range f.MacroENBIDs {
+		if err := putPLMN(b[offset:], v.PLMN); err != nil {
+			return err
+		}
+		copy(b[offset+3:offset+6], utils.Uint32To24(v.MENBI))
+		offset += menbilen
+	}
+	for _, v := range f.HomeENBIDs {
+		if err := putPLMN(b[offset:], v.PLMN); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint32(b[offset+3:offset+7], v.HENBI)
+		offset += henbilen
+	}
+	for _, v := range f.ECGIs {
+		if err := putPLMN(b[offset:], v.PLMN); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint32(b[offset+3:offset+7], v.ECI)
+		offset += ecgilen
+	}
+	for _, v := range f.RAIs {
+		if err := putPLMN(b[offset:], v.PLMN); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint16(b[offset+3:offset+5], v.LAC)
+		binary.BigEndian.PutUint16(b[offset+5:offset+7], v.RAC)
+		offset += railen
+	}
+	for _, v := range f.SAIs {
+		if err := putPLMN(b[offset:], v.PLMN); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint16(b[offset+3:offset+5], v.LAC)
+		binary.BigEndian.PutUint16(b[offset+5:offset+7], v.SAC)
+		offset += sailen
+	}
+	for _, v := range f.CGIs {
+		if err := putPLMN(b[offset:], v.PLMN); err != nil {
+			return err
+		}
+		binary.BigEndian.PutUint16(b[offset+3:offset+5], v.LAC)
+		binary.BigEndian.PutUint16(b[offset+5:offset+7], v.CI)
+		offset += cgilen
+	}
+
+	b[offset] = uint8(len(f.ExtendedMacroENBIDs))
+	offset++
+	for _, v := range f.ExtendedMacroENBIDs {
+		if err := putPLMN(b[offset:], v.PLMN); err != nil {
+			return err
+		}
+		copy(b[offset+3:offset+6], utils.Uint32To24(v.EMENBI))
+		offset += emenbilen
+	}
+
+	return nil
+}
+
+// ParsePresenceReportingAreaActionFields decodes PresenceReportingAreaActionFields.
+func ParsePresenceReportingAreaActionFields(b []byte) (*PresenceReportingAreaActionFields, error) {
+	f := &PresenceReportingAreaActionFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into PresenceReportingAreaActionFields.
+func (f *PresenceReportingAreaActionFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 4 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Action = b[0] & 0x07
+	f.INAPRA = has4thBit(b[0])
+	f.PRAID = utils.Uint24To32(b[1:4])
+
+	// the numbers of elements are not present when the action is Stop Reporting.
+	if l < 10 {
+		return nil
+	}
+	nTAI, nRAI := int(b[4]>>4), int(b[4]&0x0f)
+	nMENBI, nHENBI, nECGI := int(b[5]&0x3f), int(b[6]&0x3f), int(b[7]&0x3f)
+	nSAI, nCGI := int(b[8]&0x3f), int(b[9]&0x3f)
+	offset := 10
+
+	if l < offset+nTAI*tailen+nMENBI*menbilen+nHENBI*henbilen+nECGI*ecgilen+
+		nRAI*railen+nSAI*sailen+nCGI*cgilen {
+		return io.ErrUnexpectedEOF
+	}
+
+	var err error
+	for n := 0; n < nTAI; n++ {
+		v := &TAI{PLMN: &PLMN{}}
+		if v.MCC, v.MNC, err = utils.DecodePLMN(b[offset : offset+3]); err != nil {
+			return err
+		}
+		v.TAC = binary.BigEndian.Uint16(b[offset+3 : offset+5])
+		f.TAIs = append(f.TAIs, v)
+		offset += tailen
+	}
+	for n := 0; n < nMENBI; n++ {
+		v := &MENBI{PLMN: &PLMN{}}
+		if v.MCC, v.MNC, err = utils.DecodePLMN(b[offset : offset+3]); err != nil {
+			return err
+		}
+		v.MENBI = utils.Uint24To32(b[offset+3 : offset+6])
+		f.MacroENBIDs = append(f.MacroENBIDs, v)
+		offset += menbilen
+	}
+	for n := 0; n < nHENBI; n++ {
+		v := &HENBI{PLMN: &PLMN{}}
+		if v.MCC, v.MNC, err = utils.DecodePLMN(b[offset : offset+3]); err != nil {
+			return err
+		}
+		v.HENBI = binary.BigEndian.Uint32(b[offset+3:offset+7]) & 0xfffffff
+		f.HomeENBIDs = append(f.HomeENBIDs, v)
+		offset += henbilen
+	}
+	for n := 0; n < nECGI; n++ {
+		v := &ECGI{PLMN: &PLMN{}}
+		if v.MCC, v.MNC, err = utils.DecodePLMN(b[offset : offset+3]); err != nil {
+			return err
+		}
+		v.ECI = binary.BigEndian.Uint32(b[offset+3:offset+7]) & 0xfffffff
+		f.ECGIs = append(f.ECGIs, v)
+		offset += ecgilen
+	}
+	for n := 0; n < nRAI; n++ {
+		v := &RAI{PLMN: &PLMN{}}
+		if v.MCC, v.MNC, err = utils.DecodePLMN(b[offset : offset+3]); err != nil {
+			return err
+		}
+		v.LAC = binary.BigEndian.Uint16(b[offset+3 : offset+5])
+		v.RAC = binary.BigEndian.Uint16(b[offset+5 : offset+7])
+		f.RAIs = append(f.RAIs, v)
+		offset += railen
+	}
+	for n := 0; n < nSAI; n++ {
+		v := &SAI{PLMN: &PLMN{}}
+		if v.MCC, v.MNC, err = utils.DecodePLMN(b[offset : offset+3]); err != nil {
+			return err
+		}
+		v.LAC = binary.BigEndian.Uint16(b[offset+3 : offset+5])
+		v.SAC = binary.BigEndian.Uint16(b[offset+5 : offset+7])
+		f.SAIs = append(f.SAIs, v)
+		offset += sailen
+	}
+	for n := 0; n < nCGI; n++ {
+		v := &CGI{PLMN: &PLMN{}}
+		if v.MCC, v.MNC, err = utils.DecodePLMN(b[offset : offset+3]); err != nil {
+			return err
+		}
+		v.LAC = binary.BigEndian.Uint16(b[offset+3 : offset+5])
+		v.CI = binary.BigEndian.Uint16(b[offset+5 : offset+7])
+		f.CGIs = append(f.CGIs, v)
+		offset += cgilen
+	}
+
+	if l <= offset {
+		return nil
+	}
+	nEMENBI := int(b[offset] & 0x3f)
+	offset++
+	if l < offset+nEMENBI*emenbilen {
+		return io.ErrUnexpectedEOF
+	}
+	for n := 0; n < nEMENBI; n++ {
+		v := &EMENBI{PLMN: &PLMN{}}
+		if v.MCC, v.MNC, err = utils.DecodePLMN(b[offset : offset+3]); err != nil {
+			return err
+		}
+		v.EMENBI = utils.Uint24To32(b[offset+3 : offset+6])
+		f.ExtendedMacroENBIDs = append(f.ExtendedMacroENBIDs, v)
+		offset += emenbilen
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of PresenceReportingAreaActionFields in int.
+func (f *PresenceReportingAreaActionFields) MarshalLen() int {
+	return 10 +
+		len(f.TAIs)*tailen +
+		len(f.MacroENBIDs)*menbilen +
+		len(f.HomeENBIDs)*henbilen +
+		len(f.ECGIs)*ecgilen +
+		len(f.RAIs)*railen +
+		len(f.SAIs)*sailen +
+		len(f.CGIs)*cgilen +
+		1 + len(f.ExtendedMacroENBIDs)*emenbilen
+}
+
+// putPLMN puts the MCC and MNC in PLMN given at the beginning of b.
+func putPLMN(b []byte, p *PLMN) error {
+	if p == nil {
+		return ErrMalformed
+	}
+
+	plmn, err := utils.EncodePLMN(p.MCC, p.MNC)
+	if err != nil {
+		return err
+	}
+	copy(b[0:3], plmn)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestPresenceReportingAreaAction(t *testing.T) {
+	cases := []struct {
+		description string
+		fields      *ie.PresenceReportingAreaActionFields
+	}{
+		{
+			"StopReporting",
+			ie.NewPresenceReportingAreaActionFields(gtpv2.PresenceReportingAreaActionStopReporting, 1),
+		}, {
+			"AllElements",
+			&ie.PresenceReportingAreaActionFields{
+				INAPRA:              true,
+				Action:              gtpv2.PresenceReportingAreaActionModifyPresenceReportingAreaElements,
+				PRAID:               0xffffff,
+				TAIs:                []*ie.TAI{ie.NewTAI("123", "45", 0x1111), ie.NewTAI("123", "456", 0x2222)},
+				MacroENBIDs:         []*ie.MENBI{ie.NewMENBI("123", "45", 0x011111)},
+				HomeENBIDs:          []*ie.HENBI{ie.NewHENBI("123", "45", 0x2222222)},
+				ECGIs:               []*ie.ECGI{ie.NewECGI("123", "45", 0x3333333)},
+				RAIs:                []*ie.RAI{ie.NewRAI("123", "45", 0x1111, 0x44)},
+				SAIs:                []*ie.SAI{ie.NewSAI("123", "45", 0x1111, 0x5555)},
+				CGIs:                []*ie.CGI{ie.NewCGI("123", "45", 0x1111, 0x6666)},
+				ExtendedMacroENBIDs: []*ie.EMENBI{ie.NewEMENBI("123", "45", 0x077777)},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			i := ie.NewPresenceReportingAreaAction(c.fields)
+			got, err := i.PresenceReportingAreaAction()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(c.fields, got); diff != "" {
+				t.Error(diff)
+			}
+			if i.HasINAPRA() != c.fields.INAPRA {
+				t.Errorf("unexpected INAPRA: got %v", i.HasINAPRA())
+			}
+			if id := i.MustPRAID(); id != c.fields.PRAID {
+				t.Errorf("unexpected PRA ID: got %x, want %x", id, c.fields.PRAID)
+			}
+		})
+	}
+
+	t.Run("TooManyTAIs", func(t *testing.T) {
+		f := ie.NewPresenceReportingAreaActionFields(gtpv2.PresenceReportingAreaActionStartReporting, 1)
+		for n := 0; n < 16; n++ {
+			f.TAIs = append(f.TAIs, ie.NewTAI("123", "45", uint16(n)))
+		}
+		if _, err := f.Marshal(); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewPresenceReportingAreaInformation creates a new PresenceReportingAreaInformation IE.
+func NewPresenceReportingAreaInformation(praID uint32, inapra, apra, opra, ipra uint8) *IE {
+	i := New(PresenceReportingAreaInformation, 0x00, make([]byte, 4))
+	copy(i.Payload[0:3], utils.Uint32To24(praID&0xffffff))
+	i.Payload[3] = (inapra << 3 & 0x08) | (apra << 2 & 0x04) | (opra << 1 & 0x02) | (ipra & 0x01)
+	return i
+}
+
+// PresenceReportingAreaInformation returns the flags octet of
+// PresenceReportingAreaInformation in uint8 if the type of IE matches.
+func (i *IE) PresenceReportingAreaInformation() (uint8, error) {
+	if i.Type != PresenceReportingAreaInformation {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[3], nil
+}
+
+// MustPresenceReportingAreaInformation returns PresenceReportingAreaInformation in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustPresenceReportingAreaInformation() uint8 {
+	v, _ := i.PresenceReportingAreaInformation()
+	return v
+}
+
+// PRAID returns PRAID in uint32 if the type of IE matches.
+func (i *IE) PRAID() (uint32, error) {
+	switch i.Type {
+	case PresenceReportingAreaAction, PresenceReportingAreaInformation:
+		if len(i.Payload) < 4 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		if i.Type == PresenceReportingAreaAction {
+			return utils.Uint24To32(i.Payload[1:4]), nil
+		}
+		return utils.Uint24To32(i.Payload[0:3]), nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustPRAID returns PRAID in uint32, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustPRAID() uint32 {
+	v, _ := i.PRAID()
+	return v
+}
+
+// HasIPRA reports whether an IE has IPRA bit.
+func (i *IE) HasIPRA() bool {
+	v, err := i.PresenceReportingAreaInformation()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
+
+// HasOPRA reports whether an IE has OPRA bit.
+func (i *IE) HasOPRA() bool {
+	v, err := i.PresenceReportingAreaInformation()
+	if err != nil {
+		return false
+	}
+
+	return has2ndBit(v)
+}
+
+// HasAPRA reports whether an IE has APRA bit.
+func (i *IE) HasAPRA() bool {
+	v, err := i.PresenceReportingAreaInformation()
+	if err != nil {
+		return false
+	}
+
+	return has3rdBit(v)
+}
+
+// HasINAPRA reports whether an IE has INAPRA bit.
+func (i *IE) HasINAPRA() bool {
+	switch i.Type {
+	case PresenceReportingAreaAction:
+		if len(i.Payload) < 1 {
+			return false
+		}
+		return has4thBit(i.Payload[0])
+	case PresenceReportingAreaInformation:
+		v, err := i.PresenceReportingAreaInformation()
+		if err != nil {
+			return false
+		}
+		return has4thBit(v)
+	default:
+		return false
+	}
+}
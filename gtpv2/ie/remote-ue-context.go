@@ -0,0 +1,43 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewRemoteUEContext creates a new RemoteUEContext IE.
+//
+// remoteUserID should be a RemoteUserID IE, and remoteUEIPinfo should be a
+// RemoteUEIPinformation IE or nil(e.g., in Remote UE Context Disconnected).
+//
+// Use WithInstance(1) to put it as Remote UE Context Disconnected in
+// Remote UE Report Notification.
+func NewRemoteUEContext(remoteUserID, remoteUEIPinfo *IE) *IE {
+	var ies []*IE
+	for _, i := range []*IE{remoteUserID, remoteUEIPinfo} {
+		if i != nil {
+			ies = append(ies, i)
+		}
+	}
+	return newGroupedIE(RemoteUEContext, ies...)
+}
+
+// RemoteUEContext returns the IEs above RemoteUEContext if the type of IE matches.
+func (i *IE) RemoteUEContext() ([]*IE, error) {
+	if i.Type != RemoteUEContext {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return ParseMultiIEs(i.Payload)
+}
+
+// MustRemoteUEContext returns RemoteUEContext in []*IE, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustRemoteUEContext() []*IE {
+	v, _ := i.RemoteUEContext()
+	return v
+}
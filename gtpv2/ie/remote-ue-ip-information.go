@@ -0,0 +1,49 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"fmt"
+	"io"
+)
+
+// NewRemoteUEIPinformation creates a new RemoteUEIPinformation IE.
+//
+// info should be the Remote UE IP information encoded as defined in TS 24.301.
+func NewRemoteUEIPinformation(info []byte) *IE {
+	return New(RemoteUEIPinformation, 0x00, info)
+}
+
+// RemoteUEIPinformation returns RemoteUEIPinformation in []byte if the type of IE matches.
+func (i *IE) RemoteUEIPinformation() ([]byte, error) {
+	switch i.Type {
+	case RemoteUEIPinformation:
+		if len(i.Payload) < 1 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return i.Payload, nil
+	case RemoteUEContext:
+		ies, err := i.RemoteUEContext()
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve RemoteUEIPinformation: %w", err)
+		}
+
+		for _, child := range ies {
+			if child.Type == RemoteUEIPinformation {
+				return child.RemoteUEIPinformation()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustRemoteUEIPinformation returns RemoteUEIPinformation in []byte, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustRemoteUEIPinformation() []byte {
+	v, _ := i.RemoteUEIPinformation()
+	return v
+}
@@ -0,0 +1,191 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewRemoteUserID creates a new RemoteUserID IE.
+//
+// MSISDN and IMEI are omitted if they are empty.
+func NewRemoteUserID(imsi, msisdn, imei string) *IE {
+	v := NewRemoteUserIDFields(imsi, msisdn, imei)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(RemoteUserID, 0x00, b)
+}
+
+// RemoteUserID returns RemoteUserID in RemoteUserIDFields type if the type of IE matches.
+func (i *IE) RemoteUserID() (*RemoteUserIDFields, error) {
+	switch i.Type {
+	case RemoteUserID:
+		return ParseRemoteUserIDFields(i.Payload)
+	case RemoteUEContext:
+		ies, err := i.RemoteUEContext()
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve RemoteUserID: %w", err)
+		}
+
+		for _, child := range ies {
+			if child.Type == RemoteUserID {
+				return child.RemoteUserID()
+			}
+		}
+		return nil, ErrIENotFound
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// RemoteUserIDFields is a set of fields in RemoteUserID IE.
+type RemoteUserIDFields struct {
+	Flags  uint8
+	IMSI   string
+	MSISDN string
+	IMEI   string
+}
+
+// NewRemoteUserIDFields creates a new RemoteUserIDFields.
+func NewRemoteUserIDFields(imsi, msisdn, imei string) *RemoteUserIDFields {
+	f := &RemoteUserIDFields{IMSI: imsi}
+	if msisdn != "" {
+		f.Flags |= 0x01
+		f.MSISDN = msisdn
+	}
+	if imei != "" {
+		f.Flags |= 0x02
+		f.IMEI = imei
+	}
+
+	return f
+}
+
+// HasMSISDN reports whether MSISDN is present in RemoteUserIDFields.
+func (f *RemoteUserIDFields) HasMSISDN() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasIMEI reports whether IMEI is present in RemoteUserIDFields.
+func (f *RemoteUserIDFields) HasIMEI() bool {
+	return has2ndBit(f.Flags)
+}
+
+// Marshal serializes RemoteUserIDFields.
+func (f *RemoteUserIDFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes RemoteUserIDFields.
+func (f *RemoteUserIDFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags
+	offset := 1
+
+	ids := []string{f.IMSI}
+	if f.HasMSISDN() {
+		ids = append(ids, f.MSISDN)
+	}
+	if f.HasIMEI() {
+		ids = append(ids, f.IMEI)
+	}
+	for _, id := range ids {
+		v, err := utils.StrToSwappedBytes(id, "f")
+		if err != nil {
+			return err
+		}
+
+		b[offset] = uint8(len(v))
+		copy(b[offset+1:], v)
+		offset += 1 + len(v)
+	}
+
+	return nil
+}
+
+// ParseRemoteUserIDFields decodes RemoteUserIDFields.
+func ParseRemoteUserIDFields(b []byte) (*RemoteUserIDFields, error) {
+	f := &RemoteUserIDFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into RemoteUserIDFields.
+func (f *RemoteUserIDFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 2 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0]
+	offset := 1
+
+	var err error
+	f.IMSI, offset, err = parseRemoteUserIDValue(b, offset)
+	if err != nil {
+		return err
+	}
+	if f.HasMSISDN() {
+		f.MSISDN, offset, err = parseRemoteUserIDValue(b, offset)
+		if err != nil {
+			return err
+		}
+	}
+	if f.HasIMEI() {
+		f.IMEI, _, err = parseRemoteUserIDValue(b, offset)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of RemoteUserIDFields in int.
+func (f *RemoteUserIDFields) MarshalLen() int {
+	l := 1 + 1 + (len(f.IMSI)+1)/2
+	if f.HasMSISDN() {
+		l += 1 + (len(f.MSISDN)+1)/2
+	}
+	if f.HasIMEI() {
+		l += 1 + (len(f.IMEI)+1)/2
+	}
+
+	return l
+}
+
+// parseRemoteUserIDValue decodes the length-prefixed TBCD digits at the offset
+// given, and returns the digits and the offset of the next field.
+func parseRemoteUserIDValue(b []byte, offset int) (string, int, error) {
+	if len(b) <= offset {
+		return "", 0, io.ErrUnexpectedEOF
+	}
+
+	l := int(b[offset])
+	offset++
+	if len(b) < offset+l {
+		return "", 0, io.ErrUnexpectedEOF
+	}
+
+	str := utils.SwappedBytesToStr(b[offset:offset+l], false)
+	return strings.TrimSuffix(str, "f"), offset + l, nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestRemoteUserID(t *testing.T) {
+	cases := []struct {
+		description string
+		i           *ie.IE
+		want        *ie.RemoteUserIDFields
+	}{
+		{
+			"IMSIOnly",
+			ie.NewRemoteUserID("123451234567890", "", ""),
+			&ie.RemoteUserIDFields{IMSI: "123451234567890"},
+		}, {
+			"WithMSISDN",
+			ie.NewRemoteUserID("123451234567890", "819012345678", ""),
+			&ie.RemoteUserIDFields{Flags: 0x01, IMSI: "123451234567890", MSISDN: "819012345678"},
+		}, {
+			"WithIMEI",
+			ie.NewRemoteUserID("123451234567890", "", "123450123456789"),
+			&ie.RemoteUserIDFields{Flags: 0x02, IMSI: "123451234567890", IMEI: "123450123456789"},
+		}, {
+			"InRemoteUEContext",
+			ie.NewRemoteUEContext(
+				ie.NewRemoteUserID("123451234567890", "819012345678", "123450123456789"),
+				ie.NewRemoteUEIPinformation([]byte{0x01, 0x7f, 0x00, 0x00, 0x01}),
+			),
+			&ie.RemoteUserIDFields{Flags: 0x03, IMSI: "123451234567890", MSISDN: "819012345678", IMEI: "123450123456789"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := c.i.RemoteUserID()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(c.want, got); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
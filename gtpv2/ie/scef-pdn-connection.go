@@ -0,0 +1,40 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewSCEFPDNConnection creates a new SCEFPDNConnection IE.
+//
+// apn should be an AccessPointName IE, ebi should be an EPSBearerID IE to be used
+// as the Default EPS Bearer ID, and scefID should be a NodeIdentifier IE.
+func NewSCEFPDNConnection(apn, ebi, scefID *IE) *IE {
+	var ies []*IE
+	for _, i := range []*IE{apn, ebi, scefID} {
+		if i != nil {
+			ies = append(ies, i)
+		}
+	}
+	return newGroupedIE(SCEFPDNConnection, ies...)
+}
+
+// SCEFPDNConnection returns the IEs above SCEFPDNConnection if the type of IE matches.
+func (i *IE) SCEFPDNConnection() ([]*IE, error) {
+	if i.Type != SCEFPDNConnection {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return ParseMultiIEs(i.Payload)
+}
+
+// MustSCEFPDNConnection returns SCEFPDNConnection in []*IE, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSCEFPDNConnection() []*IE {
+	v, _ := i.SCEFPDNConnection()
+	return v
+}
@@ -0,0 +1,126 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+// NewSecondaryRATUsageDataReport creates a new SecondaryRATUsageDataReport IE.
+func NewSecondaryRATUsageDataReport(irsgw, irpgw, ratType, ebi uint8, start, end time.Time, dl, ul uint64) *IE {
+	v := NewSecondaryRATUsageDataReportFields(irsgw, irpgw, ratType, ebi, start, end, dl, ul)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(SecondaryRATUsageDataReport, 0x00, b)
+}
+
+// SecondaryRATUsageDataReport returns SecondaryRATUsageDataReport in
+// SecondaryRATUsageDataReportFields type if the type of IE matches.
+func (i *IE) SecondaryRATUsageDataReport() (*SecondaryRATUsageDataReportFields, error) {
+	switch i.Type {
+	case SecondaryRATUsageDataReport:
+		return ParseSecondaryRATUsageDataReportFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// SecondaryRATUsageDataReportFields is a set of fields in SecondaryRATUsageDataReport IE.
+type SecondaryRATUsageDataReportFields struct {
+	Flags             uint8
+	SecondaryRATType  uint8
+	EPSBearerID       uint8 // 4-bit
+	StartTimestamp    time.Time
+	EndTimestamp      time.Time
+	UsageDataDownlink uint64
+	UsageDataUplink   uint64
+}
+
+// NewSecondaryRATUsageDataReportFields creates a new SecondaryRATUsageDataReportFields.
+func NewSecondaryRATUsageDataReportFields(irsgw, irpgw, ratType, ebi uint8, start, end time.Time, dl, ul uint64) *SecondaryRATUsageDataReportFields {
+	return &SecondaryRATUsageDataReportFields{
+		Flags:             (irsgw << 1 & 0x02) | (irpgw & 0x01),
+		SecondaryRATType:  ratType,
+		EPSBearerID:       ebi & 0x0f,
+		StartTimestamp:    start,
+		EndTimestamp:      end,
+		UsageDataDownlink: dl,
+		UsageDataUplink:   ul,
+	}
+}
+
+// HasIRPGW reports whether the report is to be sent to the PGW.
+func (f *SecondaryRATUsageDataReportFields) HasIRPGW() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasIRSGW reports whether the report is to be stored at the SGW.
+func (f *SecondaryRATUsageDataReportFields) HasIRSGW() bool {
+	return has2ndBit(f.Flags)
+}
+
+// Marshal serializes SecondaryRATUsageDataReportFields.
+func (f *SecondaryRATUsageDataReportFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes SecondaryRATUsageDataReportFields.
+func (f *SecondaryRATUsageDataReportFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.Flags & 0x03
+	b[1] = f.SecondaryRATType
+	b[2] = f.EPSBearerID & 0x0f
+	binary.BigEndian.PutUint32(b[3:7], timeToNTPSeconds(f.StartTimestamp))
+	binary.BigEndian.PutUint32(b[7:11], timeToNTPSeconds(f.EndTimestamp))
+	binary.BigEndian.PutUint64(b[11:19], f.UsageDataDownlink)
+	binary.BigEndian.PutUint64(b[19:27], f.UsageDataUplink)
+
+	return nil
+}
+
+// ParseSecondaryRATUsageDataReportFields decodes SecondaryRATUsageDataReportFields.
+func ParseSecondaryRATUsageDataReportFields(b []byte) (*SecondaryRATUsageDataReportFields, error) {
+	f := &SecondaryRATUsageDataReportFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into SecondaryRATUsageDataReportFields.
+func (f *SecondaryRATUsageDataReportFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 27 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0] & 0x03
+	f.SecondaryRATType = b[1]
+	f.EPSBearerID = b[2] & 0x0f
+	f.StartTimestamp = ntpSecondsToTime(binary.BigEndian.Uint32(b[3:7]))
+	f.EndTimestamp = ntpSecondsToTime(binary.BigEndian.Uint32(b[7:11]))
+	f.UsageDataDownlink = binary.BigEndian.Uint64(b[11:19])
+	f.UsageDataUplink = binary.BigEndian.Uint64(b[19:27])
+
+	return nil
+}
+
+// MarshalLen returns the serial length of SecondaryRATUsageDataReportFields in int.
+func (f *SecondaryRATUsageDataReportFields) MarshalLen() int {
+	return 27
+}
@@ -0,0 +1,58 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestSecondaryRATUsageDataReport(t *testing.T) {
+	start := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	cases := []struct {
+		description  string
+		irsgw, irpgw uint8
+	}{
+		{"None", 0, 0},
+		{"IRPGW", 0, 1},
+		{"IRSGW", 1, 0},
+		{"Both", 1, 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			i := ie.NewSecondaryRATUsageDataReport(c.irsgw, c.irpgw, gtpv2.SecondaryRATTypeNR, 5, start, end, 1000000, 500000)
+			got, err := i.SecondaryRATUsageDataReport()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := &ie.SecondaryRATUsageDataReportFields{
+				Flags:             c.irsgw<<1 | c.irpgw,
+				SecondaryRATType:  gtpv2.SecondaryRATTypeNR,
+				EPSBearerID:       5,
+				StartTimestamp:    start,
+				EndTimestamp:      end,
+				UsageDataDownlink: 1000000,
+				UsageDataUplink:   500000,
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Error(diff)
+			}
+			if got.HasIRSGW() != (c.irsgw == 1) {
+				t.Errorf("unexpected IRSGW: got %v", got.HasIRSGW())
+			}
+			if got.HasIRPGW() != (c.irpgw == 1) {
+				t.Errorf("unexpected IRPGW: got %v", got.HasIRPGW())
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// NewSequenceNumber creates a new SequenceNumber IE.
+func NewSequenceNumber(seq uint32) *IE {
+	return newUint32ValIE(SequenceNumber, seq)
+}
+
+// SequenceNumber returns SequenceNumber in uint32 if the type of IE matches.
+//
+// For OverloadControlInformation, it is the Overload Control Sequence Number, and for
+// LoadControlInformation, it is the Load Control Sequence Number.
+func (i *IE) SequenceNumber() (uint32, error) {
+	switch i.Type {
+	case SequenceNumber:
+		if len(i.Payload) < 4 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return binary.BigEndian.Uint32(i.Payload[:4]), nil
+	case OverloadControlInformation, LoadControlInformation:
+		ies, err := ParseMultiIEs(i.Payload)
+		if err != nil {
+			return 0, fmt.Errorf("failed to retrieve SequenceNumber: %w", err)
+		}
+
+		for _, child := range ies {
+			if child.Type == SequenceNumber {
+				return child.SequenceNumber()
+			}
+		}
+		return 0, ErrIENotFound
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustSequenceNumber returns SequenceNumber in uint32, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSequenceNumber() uint32 {
+	v, _ := i.SequenceNumber()
+	return v
+}
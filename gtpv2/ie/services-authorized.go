@@ -0,0 +1,52 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewServicesAuthorized creates a new ServicesAuthorized IE.
+//
+// Use WithInstance(1) to put it as NR V2X Services Authorized in V2XContext.
+func NewServicesAuthorized(vehicleUE, pedestrianUE uint8) *IE {
+	return New(ServicesAuthorized, 0x00, []byte{vehicleUE, pedestrianUE})
+}
+
+// VehicleUEAuthorized returns Vehicle UE Authorized in uint8 if the type of IE matches.
+func (i *IE) VehicleUEAuthorized() (uint8, error) {
+	if i.Type != ServicesAuthorized {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustVehicleUEAuthorized returns VehicleUEAuthorized in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustVehicleUEAuthorized() uint8 {
+	v, _ := i.VehicleUEAuthorized()
+	return v
+}
+
+// PedestrianUEAuthorized returns Pedestrian UE Authorized in uint8 if the type of IE matches.
+func (i *IE) PedestrianUEAuthorized() (uint8, error) {
+	if i.Type != ServicesAuthorized {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 2 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[1], nil
+}
+
+// MustPedestrianUEAuthorized returns PedestrianUEAuthorized in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustPedestrianUEAuthorized() uint8 {
+	v, _ := i.PedestrianUEAuthorized()
+	return v
+}
@@ -0,0 +1,56 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewServingPLMNRateControl creates a new ServingPLMNRateControl IE.
+func NewServingPLMNRateControl(ul, dl uint16) *IE {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint16(b[0:2], ul)
+	binary.BigEndian.PutUint16(b[2:4], dl)
+	return New(ServingPLMNRateControl, 0x00, b)
+}
+
+// UplinkRateLimit returns UplinkRateLimit in uint16 if the type of IE matches.
+func (i *IE) UplinkRateLimit() (uint16, error) {
+	if i.Type != ServingPLMNRateControl {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 2 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return binary.BigEndian.Uint16(i.Payload[0:2]), nil
+}
+
+// MustUplinkRateLimit returns UplinkRateLimit in uint16, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustUplinkRateLimit() uint16 {
+	v, _ := i.UplinkRateLimit()
+	return v
+}
+
+// DownlinkRateLimit returns DownlinkRateLimit in uint16 if the type of IE matches.
+func (i *IE) DownlinkRateLimit() (uint16, error) {
+	if i.Type != ServingPLMNRateControl {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return binary.BigEndian.Uint16(i.Payload[2:4]), nil
+}
+
+// MustDownlinkRateLimit returns DownlinkRateLimit in uint16, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustDownlinkRateLimit() uint16 {
+	v, _ := i.DownlinkRateLimit()
+	return v
+}
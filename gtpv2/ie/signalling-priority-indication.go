@@ -0,0 +1,41 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewSignallingPriorityIndication creates a new SignallingPriorityIndication IE.
+func NewSignallingPriorityIndication(lapi uint8) *IE {
+	return newUint8ValIE(SignallingPriorityIndication, lapi&0x01)
+}
+
+// SignallingPriorityIndication returns SignallingPriorityIndication in uint8 if the type of IE matches.
+func (i *IE) SignallingPriorityIndication() (uint8, error) {
+	if i.Type != SignallingPriorityIndication {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustSignallingPriorityIndication returns SignallingPriorityIndication in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSignallingPriorityIndication() uint8 {
+	v, _ := i.SignallingPriorityIndication()
+	return v
+}
+
+// HasLAPI reports whether an IE has LAPI bit.
+func (i *IE) HasLAPI() bool {
+	v, err := i.SignallingPriorityIndication()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
@@ -0,0 +1,178 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewSourceIdentification creates a new SourceIdentification IE.
+//
+// The source is the Cell ID or RNC ID depending on sourceType. In case of the RNC ID,
+// the RNC-ID is set in the CI field of CellIdentifier.
+func NewSourceIdentification(target *CellIdentifier, sourceType uint8, source *CellIdentifier) *IE {
+	v := NewSourceIdentificationFields(target, sourceType, source)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(SourceIdentification, 0x00, b)
+}
+
+// SourceIdentification returns SourceIdentification in SourceIdentificationFields type
+// if the type of IE matches.
+func (i *IE) SourceIdentification() (*SourceIdentificationFields, error) {
+	switch i.Type {
+	case SourceIdentification:
+		return ParseSourceIdentificationFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// CellIdentifier represents a Cell Identifier defined in TS 48.018, which is used as
+// a field of SourceIdentification IE.
+type CellIdentifier struct {
+	MCC, MNC string
+	LAC      uint16
+	RAC      uint8
+	CI       uint16
+}
+
+// NewCellIdentifier creates a new CellIdentifier.
+func NewCellIdentifier(mcc, mnc string, lac uint16, rac uint8, ci uint16) *CellIdentifier {
+	return &CellIdentifier{
+		MCC: mcc,
+		MNC: mnc,
+		LAC: lac,
+		RAC: rac,
+		CI:  ci,
+	}
+}
+
+// MarshalTo serializes CellIdentifier.
+func (c *CellIdentifier) MarshalTo(b []byte) error {
+	if len(b) < c.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	plmn, err := utils.EncodePLMN(c.MCC, c.MNC)
+	if err != nil {
+		return err
+	}
+	copy(b[0:3], plmn)
+	binary.BigEndian.PutUint16(b[3:5], c.LAC)
+	b[5] = c.RAC
+	binary.BigEndian.PutUint16(b[6:8], c.CI)
+
+	return nil
+}
+
+// ParseCellIdentifier decodes CellIdentifier.
+func ParseCellIdentifier(b []byte) (*CellIdentifier, error) {
+	if len(b) < 8 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	mcc, mnc, err := utils.DecodePLMN(b[0:3])
+	if err != nil {
+		return nil, err
+	}
+
+	return &CellIdentifier{
+		MCC: mcc,
+		MNC: mnc,
+		LAC: binary.BigEndian.Uint16(b[3:5]),
+		RAC: b[5],
+		CI:  binary.BigEndian.Uint16(b[6:8]),
+	}, nil
+}
+
+// MarshalLen returns the serial length of CellIdentifier in int.
+func (c *CellIdentifier) MarshalLen() int {
+	return 8
+}
+
+// SourceIdentificationFields is a set of fields in SourceIdentification IE.
+type SourceIdentificationFields struct {
+	TargetCellID *CellIdentifier
+	SourceType   uint8
+	SourceID     *CellIdentifier
+}
+
+// NewSourceIdentificationFields creates a new SourceIdentificationFields.
+func NewSourceIdentificationFields(target *CellIdentifier, sourceType uint8, source *CellIdentifier) *SourceIdentificationFields {
+	return &SourceIdentificationFields{
+		TargetCellID: target,
+		SourceType:   sourceType,
+		SourceID:     source,
+	}
+}
+
+// Marshal serializes SourceIdentificationFields.
+func (f *SourceIdentificationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes SourceIdentificationFields.
+func (f *SourceIdentificationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+	if f.TargetCellID == nil || f.SourceID == nil {
+		return ErrMalformed
+	}
+
+	if err := f.TargetCellID.MarshalTo(b[0:8]); err != nil {
+		return err
+	}
+	b[8] = f.SourceType
+
+	return f.SourceID.MarshalTo(b[9:17])
+}
+
+// ParseSourceIdentificationFields decodes SourceIdentificationFields.
+func ParseSourceIdentificationFields(b []byte) (*SourceIdentificationFields, error) {
+	f := &SourceIdentificationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into SourceIdentificationFields.
+func (f *SourceIdentificationFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 17 {
+		return io.ErrUnexpectedEOF
+	}
+
+	var err error
+	f.TargetCellID, err = ParseCellIdentifier(b[0:8])
+	if err != nil {
+		return err
+	}
+	f.SourceType = b[8]
+	f.SourceID, err = ParseCellIdentifier(b[9:17])
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of SourceIdentificationFields in int.
+func (f *SourceIdentificationFields) MarshalLen() int {
+	return 17
+}
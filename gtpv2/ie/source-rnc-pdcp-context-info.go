@@ -0,0 +1,28 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+// NewSourceRNCPDCPContextInfo creates a new SourceRNCPDCPContextInfo IE.
+//
+// rrcContainer is the RRC Container defined in TS 25.331.
+func NewSourceRNCPDCPContextInfo(rrcContainer []byte) *IE {
+	return New(SourceRNCPDCPContextInfo, 0x00, rrcContainer)
+}
+
+// SourceRNCPDCPContextInfo returns SourceRNCPDCPContextInfo in []byte if the type of IE matches.
+func (i *IE) SourceRNCPDCPContextInfo() ([]byte, error) {
+	if i.Type != SourceRNCPDCPContextInfo {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+
+	return i.Payload, nil
+}
+
+// MustSourceRNCPDCPContextInfo returns SourceRNCPDCPContextInfo in []byte, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSourceRNCPDCPContextInfo() []byte {
+	v, _ := i.SourceRNCPDCPContextInfo()
+	return v
+}
@@ -0,0 +1,42 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewSourceToTargetTransparentContainer creates a new SourceToTargetTransparentContainer IE.
+func NewSourceToTargetTransparentContainer(container []byte) *IE {
+	if len(container) > 0xff {
+		return nil
+	}
+
+	i := New(SourceToTargetTransparentContainer, 0x00, make([]byte, 1+len(container)))
+	i.Payload[0] = uint8(len(container))
+	copy(i.Payload[1:], container)
+	return i
+}
+
+// SourceToTargetTransparentContainer returns SourceToTargetTransparentContainer in []byte if the type of IE matches.
+func (i *IE) SourceToTargetTransparentContainer() ([]byte, error) {
+	if i.Type != SourceToTargetTransparentContainer {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	l := int(i.Payload[0])
+	if len(i.Payload) < 1+l {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return i.Payload[1 : 1+l], nil
+}
+
+// MustSourceToTargetTransparentContainer returns SourceToTargetTransparentContainer in []byte, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSourceToTargetTransparentContainer() []byte {
+	v, _ := i.SourceToTargetTransparentContainer()
+	return v
+}
@@ -0,0 +1,85 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestSRVCCFlags(t *testing.T) {
+	cases := []struct {
+		description      string
+		i                *ie.IE
+		sti, ics, em, vf bool
+	}{
+		{"SvFlags/None", ie.NewSvFlags(0, 0, 0), false, false, false, false},
+		{"SvFlags/STI", ie.NewSvFlags(0, 0, 1), true, false, false, false},
+		{"SvFlags/ICS", ie.NewSvFlags(0, 1, 0), false, true, false, false},
+		{"SvFlags/EmInd", ie.NewSvFlags(1, 0, 0), false, false, true, false},
+		{"AdditionalFlagsForSRVCC/ICS", ie.NewAdditionalFlagsForSRVCC(0, 1), false, true, false, false},
+		{"AdditionalFlagsForSRVCC/VF", ie.NewAdditionalFlagsForSRVCC(1, 0), false, false, false, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			if got := c.i.HasSTI(); got != c.sti {
+				t.Errorf("unexpected STI: got %v, want %v", got, c.sti)
+			}
+			if got := c.i.HasICS(); got != c.ics {
+				t.Errorf("unexpected ICS: got %v, want %v", got, c.ics)
+			}
+			if got := c.i.HasEmInd(); got != c.em {
+				t.Errorf("unexpected EmInd: got %v, want %v", got, c.em)
+			}
+			if got := c.i.HasVF(); got != c.vf {
+				t.Errorf("unexpected VF: got %v, want %v", got, c.vf)
+			}
+		})
+	}
+}
+
+func TestSRVCCValues(t *testing.T) {
+	stnsr := ie.NewSTNSR(0x91, "81901234567")
+	if got := stnsr.MustSTNSR(); got != "81901234567" {
+		t.Errorf("unexpected STNSR: got %s", got)
+	}
+	if got := stnsr.MustNANPI(); got != 0x91 {
+		t.Errorf("unexpected NANPI: got %#x", got)
+	}
+
+	cm2, cm3, codecs := []byte{0x53, 0x19, 0xa2}, []byte{0x60, 0x14}, []byte{0x04, 0x02, 0x60, 0x04}
+	mm, err := ie.NewMMContextForUTRANSRVCC(1, ck, ik, kc, 2, cm2, cm3, codecs).MMContextForUTRANSRVCC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ie.NewMMContextForUTRANSRVCCFields(1, ck, ik, kc, 2, cm2, cm3, codecs)
+	if diff := cmp.Diff(want, mm); diff != "" {
+		t.Error(diff)
+	}
+
+	add, err := ie.NewAdditionalMMContextForSRVCC(cm2, nil, codecs).AdditionalMMContextForSRVCC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(ie.NewAdditionalMMContextForSRVCCFields(cm2, nil, codecs), add); diff != "" {
+		t.Error(diff)
+	}
+
+	rnc := ie.NewTargetRNCID("123", "45", 0x1111, 0x22, 0x3333, 0)
+	if mcc, mnc := rnc.MustMCC(), rnc.MustMNC(); mcc != "123" || mnc != "45" {
+		t.Errorf("unexpected PLMN: got %s-%s", mcc, mnc)
+	}
+
+	cgi, err := ie.NewTargetGlobalCellID("123", "45", 0x1111, 0x2222).TargetGlobalCellID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(ie.NewCGI("123", "45", 0x1111, 0x2222), cgi); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"strings"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewSTNSR creates a new STNSR IE.
+//
+// nanpi is the Nature of Address and Numbering Plan Indicator, which is usually
+// 0x91(international number, ISDN/telephony numbering plan).
+func NewSTNSR(nanpi uint8, stnsr string) *IE {
+	s, err := utils.StrToSwappedBytes(stnsr, "f")
+	if err != nil {
+		return nil
+	}
+
+	i := New(STNSR, 0x00, make([]byte, 1+len(s)))
+	i.Payload[0] = nanpi
+	copy(i.Payload[1:], s)
+	return i
+}
+
+// STNSR returns STNSR in string if the type of IE matches.
+func (i *IE) STNSR() (string, error) {
+	if i.Type != STNSR {
+		return "", &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 2 {
+		return "", io.ErrUnexpectedEOF
+	}
+
+	str := utils.SwappedBytesToStr(i.Payload[1:], false)
+	return strings.TrimSuffix(str, "f"), nil
+}
+
+// MustSTNSR returns STNSR in string, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSTNSR() string {
+	v, _ := i.STNSR()
+	return v
+}
+
+// NANPI returns NANPI(Nature of Address and Numbering Plan Indicator) in uint8
+// if the type of IE matches.
+func (i *IE) NANPI() (uint8, error) {
+	if i.Type != STNSR {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustNANPI returns NANPI in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustNANPI() uint8 {
+	v, _ := i.NANPI()
+	return v
+}
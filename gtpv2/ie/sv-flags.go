@@ -0,0 +1,53 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewSvFlags creates a new SvFlags IE.
+func NewSvFlags(emind, ics, sti uint8) *IE {
+	i := New(SvFlags, 0x00, make([]byte, 1))
+	i.Payload[0] |= (emind << 2 & 0x04) | (ics << 1 & 0x02) | (sti & 0x01)
+	return i
+}
+
+// SvFlags returns SvFlags in uint8 if the type of IE matches.
+func (i *IE) SvFlags() (uint8, error) {
+	if i.Type != SvFlags {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustSvFlags returns SvFlags in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSvFlags() uint8 {
+	v, _ := i.SvFlags()
+	return v
+}
+
+// HasSTI reports whether an IE has STI bit.
+func (i *IE) HasSTI() bool {
+	v, err := i.SvFlags()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
+
+// HasEmInd reports whether an IE has EmInd bit.
+func (i *IE) HasEmInd() bool {
+	v, err := i.SvFlags()
+	if err != nil {
+		return false
+	}
+
+	return has3rdBit(v)
+}
@@ -0,0 +1,47 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewTargetGlobalCellID creates a new TargetGlobalCellID IE.
+func NewTargetGlobalCellID(mcc, mnc string, lac, ci uint16) *IE {
+	plmn, err := utils.EncodePLMN(mcc, mnc)
+	if err != nil {
+		return nil
+	}
+
+	i := New(TargetGlobalCellID, 0x00, make([]byte, 7))
+	copy(i.Payload[0:3], plmn)
+	binary.BigEndian.PutUint16(i.Payload[3:5], lac)
+	binary.BigEndian.PutUint16(i.Payload[5:7], ci)
+	return i
+}
+
+// TargetGlobalCellID returns TargetGlobalCellID in CGI type if the type of IE matches.
+func (i *IE) TargetGlobalCellID() (*CGI, error) {
+	if i.Type != TargetGlobalCellID {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 7 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	mcc, mnc, err := utils.DecodePLMN(i.Payload[0:3])
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCGI(
+		mcc, mnc,
+		binary.BigEndian.Uint16(i.Payload[3:5]),
+		binary.BigEndian.Uint16(i.Payload[5:7]),
+	), nil
+}
@@ -0,0 +1,349 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// Target Type definitions.
+const (
+	targetTypeRNCID uint8 = iota
+	targetTypeMacroENodeBID
+	targetTypeCellIdentifier
+	targetTypeHomeENodeBID
+	targetTypeExtendedMacroENodeBID
+	targetTypeGNodeBID
+	targetTypeMacroNGENodeBID
+	targetTypeExtendedNGENodeBID
+)
+
+// NewTargetIdentificationRNCID creates a new TargetIdentification IE with RNC ID.
+func NewTargetIdentificationRNCID(mcc, mnc string, lac uint16, rac uint8, rncID uint16) *IE {
+	return NewTargetIdentification(&TargetIdentificationFields{
+		TargetType: targetTypeRNCID,
+		MCC:        mcc,
+		MNC:        mnc,
+		LAC:        lac,
+		RAC:        rac,
+		RNCID:      rncID,
+	})
+}
+
+// NewTargetIdentificationMacroENodeBID creates a new TargetIdentification IE with Macro eNodeB ID.
+func NewTargetIdentificationMacroENodeBID(mcc, mnc string, enbID uint32, tac uint16) *IE {
+	return NewTargetIdentification(&TargetIdentificationFields{
+		TargetType: targetTypeMacroENodeBID,
+		MCC:        mcc,
+		MNC:        mnc,
+		NodeID:     enbID,
+		TAC:        tac,
+	})
+}
+
+// NewTargetIdentificationCellIdentifier creates a new TargetIdentification IE with Cell Identifier.
+func NewTargetIdentificationCellIdentifier(mcc, mnc string, lac uint16, rac uint8, ci uint16) *IE {
+	return NewTargetIdentification(&TargetIdentificationFields{
+		TargetType: targetTypeCellIdentifier,
+		MCC:        mcc,
+		MNC:        mnc,
+		LAC:        lac,
+		RAC:        rac,
+		CI:         ci,
+	})
+}
+
+// NewTargetIdentificationHomeENodeBID creates a new TargetIdentification IE with Home eNodeB ID.
+func NewTargetIdentificationHomeENodeBID(mcc, mnc string, henbID uint32, tac uint16) *IE {
+	return NewTargetIdentification(&TargetIdentificationFields{
+		TargetType: targetTypeHomeENodeBID,
+		MCC:        mcc,
+		MNC:        mnc,
+		NodeID:     henbID,
+		TAC:        tac,
+	})
+}
+
+// NewTargetIdentificationExtendedMacroENodeBID creates a new TargetIdentification IE with
+// Extended Macro eNodeB ID.
+func NewTargetIdentificationExtendedMacroENodeBID(mcc, mnc string, smenb bool, enbID uint32, tac uint16) *IE {
+	return NewTargetIdentification(&TargetIdentificationFields{
+		TargetType: targetTypeExtendedMacroENodeBID,
+		MCC:        mcc,
+		MNC:        mnc,
+		SMeNB:      smenb,
+		NodeID:     enbID,
+		TAC:        tac,
+	})
+}
+
+// NewTargetIdentificationGNodeBID creates a new TargetIdentification IE with gNodeB ID.
+func NewTargetIdentificationGNodeBID(mcc, mnc string, idLen uint8, gnbID, fiveGSTAC uint32) *IE {
+	return NewTargetIdentification(&TargetIdentificationFields{
+		TargetType:   targetTypeGNodeBID,
+		MCC:          mcc,
+		MNC:          mnc,
+		NodeIDLength: idLen,
+		NodeID:       gnbID,
+		FiveGSTAC:    fiveGSTAC,
+	})
+}
+
+// NewTargetIdentificationMacroNGENodeBID creates a new TargetIdentification IE with
+// Macro ng-eNodeB ID.
+func NewTargetIdentificationMacroNGENodeBID(mcc, mnc string, ngenbID, fiveGSTAC uint32) *IE {
+	return NewTargetIdentification(&TargetIdentificationFields{
+		TargetType: targetTypeMacroNGENodeBID,
+		MCC:        mcc,
+		MNC:        mnc,
+		NodeID:     ngenbID,
+		FiveGSTAC:  fiveGSTAC,
+	})
+}
+
+// NewTargetIdentificationExtendedNGENodeBID creates a new TargetIdentification IE with
+// Extended ng-eNodeB ID.
+func NewTargetIdentificationExtendedNGENodeBID(mcc, mnc string, smenb bool, ngenbID, fiveGSTAC uint32) *IE {
+	return NewTargetIdentification(&TargetIdentificationFields{
+		TargetType: targetTypeExtendedNGENodeBID,
+		MCC:        mcc,
+		MNC:        mnc,
+		SMeNB:      smenb,
+		NodeID:     ngenbID,
+		FiveGSTAC:  fiveGSTAC,
+	})
+}
+
+// NewTargetIdentification creates a new TargetIdentification IE from TargetIdentificationFields.
+func NewTargetIdentification(f *TargetIdentificationFields) *IE {
+	b, err := f.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(TargetIdentification, 0x00, b)
+}
+
+// TargetIdentification returns TargetIdentification in TargetIdentificationFields type
+// if the type of IE matches.
+func (i *IE) TargetIdentification() (*TargetIdentificationFields, error) {
+	switch i.Type {
+	case TargetIdentification:
+		return ParseTargetIdentificationFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// TargetType returns TargetType in uint8 if the type of IE matches.
+func (i *IE) TargetType() (uint8, error) {
+	switch i.Type {
+	case TargetIdentification:
+		if len(i.Payload) < 1 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return i.Payload[0], nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustTargetType returns TargetType in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustTargetType() uint8 {
+	v, _ := i.TargetType()
+	return v
+}
+
+// TargetIdentificationFields is a set of fields in TargetIdentification IE.
+//
+// The fields that are not used in the TargetType are ignored. For the TargetType
+// that is not supported, the Target ID is kept as it is in TargetID.
+type TargetIdentificationFields struct {
+	TargetType uint8
+	MCC, MNC   string
+
+	LAC           uint16 // RNC ID and Cell Identifier
+	RAC           uint8  // RNC ID and Cell Identifier
+	RNCID         uint16 // RNC ID
+	ExtendedRNCID uint16 // RNC ID, encoded only if not zero
+	CI            uint16 // Cell Identifier
+
+	// NodeID is the eNodeB ID, ng-eNodeB ID or gNodeB ID.
+	NodeID       uint32
+	NodeIDLength uint8 // gNodeB ID
+	SMeNB        bool  // Extended Macro eNodeB ID and Extended ng-eNodeB ID
+	TAC          uint16
+	FiveGSTAC    uint32 // 24-bit
+
+	TargetID []byte
+}
+
+// Marshal serializes TargetIdentificationFields.
+func (f *TargetIdentificationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes TargetIdentificationFields.
+func (f *TargetIdentificationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	b[0] = f.TargetType
+	if !f.isSupported() {
+		copy(b[1:], f.TargetID)
+		return nil
+	}
+
+	plmn, err := utils.EncodePLMN(f.MCC, f.MNC)
+	if err != nil {
+		return err
+	}
+	copy(b[1:4], plmn)
+
+	switch f.TargetType {
+	case targetTypeRNCID:
+		binary.BigEndian.PutUint16(b[4:6], f.LAC)
+		b[6] = f.RAC
+		binary.BigEndian.PutUint16(b[7:9], f.RNCID)
+		if f.ExtendedRNCID != 0 {
+			binary.BigEndian.PutUint16(b[9:11], f.ExtendedRNCID)
+		}
+	case targetTypeMacroENodeBID:
+		copy(b[4:7], utils.Uint32To24(f.NodeID&0xfffff))
+		binary.BigEndian.PutUint16(b[7:9], f.TAC)
+	case targetTypeCellIdentifier:
+		binary.BigEndian.PutUint16(b[4:6], f.LAC)
+		b[6] = f.RAC
+		binary.BigEndian.PutUint16(b[7:9], f.CI)
+	case targetTypeHomeENodeBID:
+		binary.BigEndian.PutUint32(b[4:8], f.NodeID&0xfffffff)
+		binary.BigEndian.PutUint16(b[8:10], f.TAC)
+	case targetTypeExtendedMacroENodeBID:
+		copy(b[4:7], utils.Uint32To24(f.NodeID&0x1fffff))
+		if f.SMeNB {
+			b[4] |= 0x80
+		}
+		binary.BigEndian.PutUint16(b[7:9], f.TAC)
+	case targetTypeGNodeBID:
+		b[4] = f.NodeIDLength & 0x3f
+		binary.BigEndian.PutUint32(b[5:9], f.NodeID)
+		copy(b[9:12], utils.Uint32To24(f.FiveGSTAC))
+	case targetTypeMacroNGENodeBID:
+		copy(b[4:7], utils.Uint32To24(f.NodeID&0xfffff))
+		copy(b[7:10], utils.Uint32To24(f.FiveGSTAC))
+	case targetTypeExtendedNGENodeBID:
+		copy(b[4:7], utils.Uint32To24(f.NodeID&0x1fffff))
+		if f.SMeNB {
+			b[4] |= 0x80
+		}
+		copy(b[7:10], utils.Uint32To24(f.FiveGSTAC))
+	}
+
+	return nil
+}
+
+// ParseTargetIdentificationFields decodes TargetIdentificationFields.
+func ParseTargetIdentificationFields(b []byte) (*TargetIdentificationFields, error) {
+	f := &TargetIdentificationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into TargetIdentificationFields.
+func (f *TargetIdentificationFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 1 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.TargetType = b[0]
+	if !f.isSupported() {
+		f.TargetID = b[1:]
+		return nil
+	}
+
+	if l < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	var err error
+	f.MCC, f.MNC, err = utils.DecodePLMN(b[1:4])
+	if err != nil {
+		return err
+	}
+
+	switch f.TargetType {
+	case targetTypeRNCID:
+		f.LAC = binary.BigEndian.Uint16(b[4:6])
+		f.RAC = b[6]
+		f.RNCID = binary.BigEndian.Uint16(b[7:9])
+		if l >= 11 {
+			f.ExtendedRNCID = binary.BigEndian.Uint16(b[9:11])
+		}
+	case targetTypeMacroENodeBID:
+		f.NodeID = utils.Uint24To32(b[4:7]) & 0xfffff
+		f.TAC = binary.BigEndian.Uint16(b[7:9])
+	case targetTypeCellIdentifier:
+		f.LAC = binary.BigEndian.Uint16(b[4:6])
+		f.RAC = b[6]
+		f.CI = binary.BigEndian.Uint16(b[7:9])
+	case targetTypeHomeENodeBID:
+		f.NodeID = binary.BigEndian.Uint32(b[4:8]) & 0xfffffff
+		f.TAC = binary.BigEndian.Uint16(b[8:10])
+	case targetTypeExtendedMacroENodeBID:
+		f.SMeNB = has8thBit(b[4])
+		f.NodeID = utils.Uint24To32(b[4:7]) & 0x1fffff
+		f.TAC = binary.BigEndian.Uint16(b[7:9])
+	case targetTypeGNodeBID:
+		f.NodeIDLength = b[4] & 0x3f
+		f.NodeID = binary.BigEndian.Uint32(b[5:9])
+		f.FiveGSTAC = utils.Uint24To32(b[9:12])
+	case targetTypeMacroNGENodeBID:
+		f.NodeID = utils.Uint24To32(b[4:7]) & 0xfffff
+		f.FiveGSTAC = utils.Uint24To32(b[7:10])
+	case targetTypeExtendedNGENodeBID:
+		f.SMeNB = has8thBit(b[4])
+		f.NodeID = utils.Uint24To32(b[4:7]) & 0x1fffff
+		f.FiveGSTAC = utils.Uint24To32(b[7:10])
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of TargetIdentificationFields in int.
+func (f *TargetIdentificationFields) MarshalLen() int {
+	switch f.TargetType {
+	case targetTypeRNCID:
+		if f.ExtendedRNCID != 0 {
+			return 11
+		}
+		return 9
+	case targetTypeMacroENodeBID, targetTypeCellIdentifier, targetTypeExtendedMacroENodeBID:
+		return 9
+	case targetTypeHomeENodeBID, targetTypeMacroNGENodeBID, targetTypeExtendedNGENodeBID:
+		return 10
+	case targetTypeGNodeBID:
+		return 12
+	default:
+		return 1 + len(f.TargetID)
+	}
+}
+
+func (f *TargetIdentificationFields) isSupported() bool {
+	return f.TargetType <= targetTypeExtendedNGENodeBID
+}
@@ -0,0 +1,76 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestTargetIdentification(t *testing.T) {
+	cases := []struct {
+		description string
+		structured  *ie.IE
+		fields      *ie.TargetIdentificationFields
+	}{
+		{
+			"RNCID",
+			ie.NewTargetIdentificationRNCID("123", "45", 0x1111, 0x22, 0x0333),
+			&ie.TargetIdentificationFields{
+				TargetType: gtpv2.TargetTypeRNCID, MCC: "123", MNC: "45", LAC: 0x1111, RAC: 0x22, RNCID: 0x0333,
+			},
+		}, {
+			"RNCID/Extended",
+			ie.NewTargetIdentification(&ie.TargetIdentificationFields{
+				TargetType: gtpv2.TargetTypeRNCID, MCC: "123", MNC: "456", LAC: 0x1111, RAC: 0x22, RNCID: 0x0333, ExtendedRNCID: 0x4444,
+			}),
+			&ie.TargetIdentificationFields{
+				TargetType: gtpv2.TargetTypeRNCID, MCC: "123", MNC: "456", LAC: 0x1111, RAC: 0x22, RNCID: 0x0333, ExtendedRNCID: 0x4444,
+			},
+		}, {
+			"MacroENodeBID",
+			ie.NewTargetIdentificationMacroENodeBID("123", "45", 0x12345, 0x0001),
+			&ie.TargetIdentificationFields{
+				TargetType: gtpv2.TargetTypeMacroENodeBID, MCC: "123", MNC: "45", NodeID: 0x12345, TAC: 0x0001,
+			},
+		}, {
+			"ExtendedMacroENodeBID",
+			ie.NewTargetIdentificationExtendedMacroENodeBID("123", "45", true, 0x1fffff, 0x0001),
+			&ie.TargetIdentificationFields{
+				TargetType: gtpv2.TargetTypeExtendedMacroENodeBID, MCC: "123", MNC: "45", SMeNB: true, NodeID: 0x1fffff, TAC: 0x0001,
+			},
+		}, {
+			"GNodeBID",
+			ie.NewTargetIdentificationGNodeBID("123", "45", 32, 0xffffffff, 0x123456),
+			&ie.TargetIdentificationFields{
+				TargetType: gtpv2.TargetTypeGNodeBID, MCC: "123", MNC: "45", NodeIDLength: 32, NodeID: 0xffffffff, FiveGSTAC: 0x123456,
+			},
+		}, {
+			"Unknown",
+			ie.New(ie.TargetIdentification, 0x00, []byte{0x0f, 0xde, 0xad}),
+			&ie.TargetIdentificationFields{TargetType: 0x0f, TargetID: []byte{0xde, 0xad}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			got, err := c.structured.TargetIdentification()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(got, c.fields, cmpopts.EquateEmpty()); diff != "" {
+				t.Error(diff)
+			}
+			if tt := c.structured.MustTargetType(); tt != c.fields.TargetType {
+				t.Errorf("unexpected TargetType: got %d, want %d", tt, c.fields.TargetType)
+			}
+		})
+	}
+}
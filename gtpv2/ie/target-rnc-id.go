@@ -0,0 +1,127 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewTargetRNCID creates a new TargetRNCID IE.
+//
+// Extended RNC-ID is included only if extRNCID is not zero.
+func NewTargetRNCID(mcc, mnc string, lac uint16, rac uint8, rncID, extRNCID uint16) *IE {
+	v := NewTargetRNCIDFields(mcc, mnc, lac, rac, rncID, extRNCID)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(TargetRNCID, 0x00, b)
+}
+
+// TargetRNCID returns TargetRNCID in TargetRNCIDFields type if the type of IE matches.
+func (i *IE) TargetRNCID() (*TargetRNCIDFields, error) {
+	switch i.Type {
+	case TargetRNCID:
+		return ParseTargetRNCIDFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// TargetRNCIDFields is a set of fields in TargetRNCID IE.
+type TargetRNCIDFields struct {
+	MCC, MNC      string
+	LAC           uint16
+	RAC           uint8
+	RNCID         uint16
+	ExtendedRNCID uint16
+}
+
+// NewTargetRNCIDFields creates a new TargetRNCIDFields.
+func NewTargetRNCIDFields(mcc, mnc string, lac uint16, rac uint8, rncID, extRNCID uint16) *TargetRNCIDFields {
+	return &TargetRNCIDFields{
+		MCC:           mcc,
+		MNC:           mnc,
+		LAC:           lac,
+		RAC:           rac,
+		RNCID:         rncID,
+		ExtendedRNCID: extRNCID,
+	}
+}
+
+// Marshal serializes TargetRNCIDFields.
+func (f *TargetRNCIDFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes TargetRNCIDFields.
+func (f *TargetRNCIDFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	plmn, err := utils.EncodePLMN(f.MCC, f.MNC)
+	if err != nil {
+		return err
+	}
+	copy(b[0:3], plmn)
+	binary.BigEndian.PutUint16(b[3:5], f.LAC)
+	b[5] = f.RAC
+	binary.BigEndian.PutUint16(b[6:8], f.RNCID)
+	if f.ExtendedRNCID != 0 {
+		binary.BigEndian.PutUint16(b[8:10], f.ExtendedRNCID)
+	}
+
+	return nil
+}
+
+// ParseTargetRNCIDFields decodes TargetRNCIDFields.
+func ParseTargetRNCIDFields(b []byte) (*TargetRNCIDFields, error) {
+	f := &TargetRNCIDFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into TargetRNCIDFields.
+func (f *TargetRNCIDFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 8 {
+		return io.ErrUnexpectedEOF
+	}
+
+	var err error
+	f.MCC, f.MNC, err = utils.DecodePLMN(b[0:3])
+	if err != nil {
+		return err
+	}
+	f.LAC = binary.BigEndian.Uint16(b[3:5])
+	f.RAC = b[5]
+	f.RNCID = binary.BigEndian.Uint16(b[6:8])
+	if l >= 10 {
+		f.ExtendedRNCID = binary.BigEndian.Uint16(b[8:10])
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of TargetRNCIDFields in int.
+func (f *TargetRNCIDFields) MarshalLen() int {
+	if f.ExtendedRNCID != 0 {
+		return 10
+	}
+	return 8
+}
@@ -0,0 +1,42 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewTargetToSourceTransparentContainer creates a new TargetToSourceTransparentContainer IE.
+func NewTargetToSourceTransparentContainer(container []byte) *IE {
+	if len(container) > 0xff {
+		return nil
+	}
+
+	i := New(TargetToSourceTransparentContainer, 0x00, make([]byte, 1+len(container)))
+	i.Payload[0] = uint8(len(container))
+	copy(i.Payload[1:], container)
+	return i
+}
+
+// TargetToSourceTransparentContainer returns TargetToSourceTransparentContainer in []byte if the type of IE matches.
+func (i *IE) TargetToSourceTransparentContainer() ([]byte, error) {
+	if i.Type != TargetToSourceTransparentContainer {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	l := int(i.Payload[0])
+	if len(i.Payload) < 1+l {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return i.Payload[1 : 1+l], nil
+}
+
+// MustTargetToSourceTransparentContainer returns TargetToSourceTransparentContainer in []byte, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustTargetToSourceTransparentContainer() []byte {
+	v, _ := i.TargetToSourceTransparentContainer()
+	return v
+}
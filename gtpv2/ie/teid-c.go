@@ -0,0 +1,34 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// NewTEIDC creates a new TEIDC IE.
+func NewTEIDC(teid uint32) *IE {
+	return newUint32ValIE(TEIDC, teid)
+}
+
+// TEIDC returns TEIDC in uint32 if the type of IE matches.
+func (i *IE) TEIDC() (uint32, error) {
+	if i.Type != TEIDC {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 4 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return binary.BigEndian.Uint32(i.Payload[0:4]), nil
+}
+
+// MustTEIDC returns TEIDC in uint32, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustTEIDC() uint32 {
+	v, _ := i.TEIDC()
+	return v
+}
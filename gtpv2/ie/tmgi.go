@@ -0,0 +1,138 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewTMGI creates a new TMGI IE.
+//
+// MCC and MNC are omitted if mcc is empty.
+func NewTMGI(serviceID uint32, mcc, mnc string) *IE {
+	v := NewTMGIFields(serviceID, mcc, mnc)
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(TMGI, 0x00, b)
+}
+
+// TMGI returns TMGI in TMGIFields type if the type of IE matches.
+func (i *IE) TMGI() (*TMGIFields, error) {
+	switch i.Type {
+	case TMGI:
+		return ParseTMGIFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// TMGIFields is a set of fields in TMGI IE.
+type TMGIFields struct {
+	MBMSServiceID uint32 // 24-bit
+	MCC, MNC      string
+}
+
+// NewTMGIFields creates a new TMGIFields.
+func NewTMGIFields(serviceID uint32, mcc, mnc string) *TMGIFields {
+	return &TMGIFields{
+		MBMSServiceID: serviceID,
+		MCC:           mcc,
+		MNC:           mnc,
+	}
+}
+
+// Marshal serializes TMGIFields.
+func (f *TMGIFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes TMGIFields.
+func (f *TMGIFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	copy(b[0:3], utils.Uint32To24(f.MBMSServiceID))
+	if f.MCC == "" {
+		return nil
+	}
+
+	plmn, err := utils.EncodePLMN(f.MCC, f.MNC)
+	if err != nil {
+		return err
+	}
+	copy(b[3:6], plmn)
+
+	return nil
+}
+
+// ParseTMGIFields decodes TMGIFields.
+func ParseTMGIFields(b []byte) (*TMGIFields, error) {
+	f := &TMGIFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into TMGIFields.
+func (f *TMGIFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 3 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.MBMSServiceID = utils.Uint24To32(b[0:3])
+	if l < 6 {
+		return nil
+	}
+
+	var err error
+	f.MCC, f.MNC, err = utils.DecodePLMN(b[3:6])
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of TMGIFields in int.
+func (f *TMGIFields) MarshalLen() int {
+	if f.MCC == "" {
+		return 3
+	}
+	return 6
+}
+
+// MBMSServiceID returns MBMSServiceID in uint32 if the type of IE matches.
+func (i *IE) MBMSServiceID() (uint32, error) {
+	switch i.Type {
+	case TMGI:
+		if len(i.Payload) < 3 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return utils.Uint24To32(i.Payload[0:3]), nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustMBMSServiceID returns MBMSServiceID in uint32, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustMBMSServiceID() uint32 {
+	v, _ := i.MBMSServiceID()
+	return v
+}
@@ -0,0 +1,229 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+// NewTraceInformation creates a new TraceInformation IE.
+//
+// triggeringEvents and interfaces are the bitmaps defined in TS 32.422, which are
+// 9 and 12 octets long respectively. They are padded with zeros or truncated if the
+// length differs. ip is the IP Address of Trace Collection Entity in IPv4 or IPv6.
+func NewTraceInformation(mcc, mnc string, traceID uint32, triggeringEvents []byte, neTypes uint16, depth uint8, interfaces []byte, ip string) *IE {
+	v := NewTraceInformationFields(mcc, mnc, traceID, triggeringEvents, neTypes, depth, interfaces, net.ParseIP(ip))
+	b, err := v.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(TraceInformation, 0x00, b)
+}
+
+// TraceInformation returns TraceInformation in TraceInformationFields type if the type of IE matches.
+func (i *IE) TraceInformation() (*TraceInformationFields, error) {
+	switch i.Type {
+	case TraceInformation:
+		return ParseTraceInformationFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// TraceInformationFields is a set of fields in TraceInformation IE.
+type TraceInformationFields struct {
+	MCC, MNC                         string
+	TraceID                          uint32 // 24-bit
+	TriggeringEvents                 []byte // 9 octets
+	ListOfNETypes                    uint16
+	SessionTraceDepth                uint8
+	ListOfInterfaces                 []byte // 12 octets
+	IPAddressOfTraceCollectionEntity net.IP
+}
+
+// NewTraceInformationFields creates a new TraceInformationFields.
+func NewTraceInformationFields(mcc, mnc string, traceID uint32, triggeringEvents []byte, neTypes uint16, depth uint8, interfaces []byte, ip net.IP) *TraceInformationFields {
+	f := &TraceInformationFields{
+		MCC:                              mcc,
+		MNC:                              mnc,
+		TraceID:                          traceID,
+		TriggeringEvents:                 make([]byte, 9),
+		ListOfNETypes:                    neTypes,
+		SessionTraceDepth:                depth,
+		ListOfInterfaces:                 make([]byte, 12),
+		IPAddressOfTraceCollectionEntity: ip,
+	}
+	copy(f.TriggeringEvents, triggeringEvents)
+	copy(f.ListOfInterfaces, interfaces)
+
+	if v := ip.To4(); v != nil {
+		f.IPAddressOfTraceCollectionEntity = v
+	}
+	return f
+}
+
+// Marshal serializes TraceInformationFields.
+func (f *TraceInformationFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes TraceInformationFields.
+func (f *TraceInformationFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+
+	plmn, err := utils.EncodePLMN(f.MCC, f.MNC)
+	if err != nil {
+		return err
+	}
+	copy(b[0:3], plmn)
+	copy(b[3:6], utils.Uint32To24(f.TraceID))
+	copy(b[6:15], f.TriggeringEvents)
+	binary.BigEndian.PutUint16(b[15:17], f.ListOfNETypes)
+	b[17] = f.SessionTraceDepth
+	copy(b[18:30], f.ListOfInterfaces)
+	copy(b[30:], f.IPAddressOfTraceCollectionEntity)
+
+	return nil
+}
+
+// ParseTraceInformationFields decodes TraceInformationFields.
+func ParseTraceInformationFields(b []byte) (*TraceInformationFields, error) {
+	f := &TraceInformationFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into TraceInformationFields.
+func (f *TraceInformationFields) UnmarshalBinary(b []byte) error {
+	if len(b) < 30 {
+		return io.ErrUnexpectedEOF
+	}
+
+	var err error
+	f.MCC, f.MNC, err = utils.DecodePLMN(b[0:3])
+	if err != nil {
+		return err
+	}
+	f.TraceID = utils.Uint24To32(b[3:6])
+	f.TriggeringEvents = make([]byte, 9)
+	copy(f.TriggeringEvents, b[6:15])
+	f.ListOfNETypes = binary.BigEndian.Uint16(b[15:17])
+	f.SessionTraceDepth = b[17]
+	f.ListOfInterfaces = make([]byte, 12)
+	copy(f.ListOfInterfaces, b[18:30])
+
+	switch len(b[30:]) {
+	case 4, 16:
+		f.IPAddressOfTraceCollectionEntity = make(net.IP, len(b[30:]))
+		copy(f.IPAddressOfTraceCollectionEntity, b[30:])
+	default:
+		return ErrMalformed
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of TraceInformationFields in int.
+func (f *TraceInformationFields) MarshalLen() int {
+	return 30 + len(f.IPAddressOfTraceCollectionEntity)
+}
+
+// TriggeringEvents returns TriggeringEvents in []byte if the type of IE matches.
+func (i *IE) TriggeringEvents() ([]byte, error) {
+	switch i.Type {
+	case TraceInformation:
+		f, err := i.TraceInformation()
+		if err != nil {
+			return nil, err
+		}
+		return f.TriggeringEvents, nil
+	case ExtendedTraceInformation:
+		f, err := i.ExtendedTraceInformation()
+		if err != nil {
+			return nil, err
+		}
+		return f.TriggeringEvents, nil
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustTriggeringEvents returns TriggeringEvents in []byte, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustTriggeringEvents() []byte {
+	v, _ := i.TriggeringEvents()
+	return v
+}
+
+// SessionTraceDepth returns SessionTraceDepth in uint8 if the type of IE matches.
+func (i *IE) SessionTraceDepth() (uint8, error) {
+	switch i.Type {
+	case TraceInformation:
+		f, err := i.TraceInformation()
+		if err != nil {
+			return 0, err
+		}
+		return f.SessionTraceDepth, nil
+	case ExtendedTraceInformation:
+		f, err := i.ExtendedTraceInformation()
+		if err != nil {
+			return 0, err
+		}
+		return f.SessionTraceDepth, nil
+	default:
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustSessionTraceDepth returns SessionTraceDepth in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSessionTraceDepth() uint8 {
+	v, _ := i.SessionTraceDepth()
+	return v
+}
+
+// TraceCollectionEntityIP returns IP Address of Trace Collection Entity in net.IP
+// if the type of IE matches.
+func (i *IE) TraceCollectionEntityIP() (net.IP, error) {
+	switch i.Type {
+	case TraceInformation:
+		f, err := i.TraceInformation()
+		if err != nil {
+			return nil, err
+		}
+		return f.IPAddressOfTraceCollectionEntity, nil
+	case ExtendedTraceInformation:
+		f, err := i.ExtendedTraceInformation()
+		if err != nil {
+			return nil, err
+		}
+		return f.IPAddressOfTraceCollectionEntity, nil
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// MustTraceCollectionEntityIP returns IP Address of Trace Collection Entity in net.IP,
+// ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustTraceCollectionEntityIP() net.IP {
+	v, _ := i.TraceCollectionEntityIP()
+	return v
+}
@@ -0,0 +1,85 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestTraceInformation(t *testing.T) {
+	events := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09}
+	interfaces := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c}
+
+	ti := ie.NewTraceInformation("123", "45", 1, events, 0x0301, gtpv2.TraceDepthMedium, interfaces, "1.1.1.1")
+	got, err := ti.TraceInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ie.TraceInformationFields{
+		MCC: "123", MNC: "45", TraceID: 1,
+		TriggeringEvents:                 events,
+		ListOfNETypes:                    0x0301,
+		SessionTraceDepth:                gtpv2.TraceDepthMedium,
+		ListOfInterfaces:                 interfaces,
+		IPAddressOfTraceCollectionEntity: net.IP{0x01, 0x01, 0x01, 0x01},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+
+	if got := ti.MustTraceID(); got != 1 {
+		t.Errorf("unexpected TraceID: got %d, want 1", got)
+	}
+	if got := ti.MustMCC(); got != "123" {
+		t.Errorf("unexpected MCC: got %s, want 123", got)
+	}
+	if got := ti.MustSessionTraceDepth(); got != gtpv2.TraceDepthMedium {
+		t.Errorf("unexpected SessionTraceDepth: got %d, want %d", got, gtpv2.TraceDepthMedium)
+	}
+	if got := ti.MustTraceCollectionEntityIP(); !got.Equal(net.ParseIP("1.1.1.1")) {
+		t.Errorf("unexpected IP: got %s, want 1.1.1.1", got)
+	}
+
+	// short bitmaps are padded with zeros.
+	ti = ie.NewTraceInformation("123", "45", 1, []byte{0x01}, 0x0301, gtpv2.TraceDepthMedium, nil, "1.1.1.1")
+	if got := ti.MustTriggeringEvents(); len(got) != 9 || got[0] != 0x01 {
+		t.Errorf("unexpected TriggeringEvents: %x", got)
+	}
+}
+
+func TestExtendedTraceInformation(t *testing.T) {
+	eti := ie.NewExtendedTraceInformation(
+		"123", "45", 1, []byte{0x01, 0x02}, []byte{0x03, 0x01, 0x80},
+		gtpv2.TraceDepthMinimum, []byte{0xff}, "2001::1",
+	)
+	got, err := eti.ExtendedTraceInformation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ie.ExtendedTraceInformationFields{
+		MCC: "123", MNC: "45", TraceID: 1,
+		TriggeringEvents:                 []byte{0x01, 0x02},
+		ListOfNETypes:                    []byte{0x03, 0x01, 0x80},
+		SessionTraceDepth:                gtpv2.TraceDepthMinimum,
+		ListOfInterfaces:                 []byte{0xff},
+		IPAddressOfTraceCollectionEntity: net.ParseIP("2001::1"),
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Error(diff)
+	}
+
+	if got := eti.MustTraceID(); got != 1 {
+		t.Errorf("unexpected TraceID: got %d, want 1", got)
+	}
+	if _, err := ie.ParseExtendedTraceInformationFields(eti.Payload[:len(eti.Payload)-1]); err == nil {
+		t.Error("truncated ExtendedTraceInformation should fail to decode")
+	}
+}
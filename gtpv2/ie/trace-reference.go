@@ -106,7 +106,7 @@ func (f *TraceReferenceFields) MarshalLen() int {
 // TraceID returns TraceID in uint32 if the type of IE matches.
 func (i *IE) TraceID() (uint32, error) {
 	switch i.Type {
-	case TraceReference, TraceInformation:
+	case TraceReference, TraceInformation, ExtendedTraceInformation:
 		if len(i.Payload) < 6 {
 			return 0, io.ErrUnexpectedEOF
 		}
@@ -0,0 +1,53 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewTrustedWLANModeIndication creates a new TrustedWLANModeIndication IE.
+func NewTrustedWLANModeIndication(mcm, scm uint8) *IE {
+	i := New(TrustedWLANModeIndication, 0x00, make([]byte, 1))
+	i.Payload[0] |= (mcm << 1 & 0x02) | (scm & 0x01)
+	return i
+}
+
+// TrustedWLANModeIndication returns TrustedWLANModeIndication in uint8 if the type of IE matches.
+func (i *IE) TrustedWLANModeIndication() (uint8, error) {
+	if i.Type != TrustedWLANModeIndication {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustTrustedWLANModeIndication returns TrustedWLANModeIndication in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustTrustedWLANModeIndication() uint8 {
+	v, _ := i.TrustedWLANModeIndication()
+	return v
+}
+
+// HasSCM reports whether an IE has SCM bit.
+func (i *IE) HasSCM() bool {
+	v, err := i.TrustedWLANModeIndication()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
+
+// HasMCM reports whether an IE has MCM bit.
+func (i *IE) HasMCM() bool {
+	v, err := i.TrustedWLANModeIndication()
+	if err != nil {
+		return false
+	}
+
+	return has2ndBit(v)
+}
@@ -0,0 +1,15 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "time"
+
+// NewTWANIdentifierTimestamp creates a new TWANIdentifierTimestamp IE.
+//
+// Use WithInstance(1) to put it as WLAN Location Timestamp.
+// The value can be retrieved with Timestamp().
+func NewTWANIdentifierTimestamp(ts time.Time) *IE {
+	return newUint32ValIE(TWANIdentifierTimestamp, timeToNTPSeconds(ts))
+}
@@ -0,0 +1,322 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import (
+	"io"
+	"net"
+
+	"github.com/wmnsk/go-gtp/utils"
+)
+
+const (
+	twanFlagBSSID    uint8 = 0x01
+	twanFlagCivic    uint8 = 0x02
+	twanFlagPLMNID   uint8 = 0x04
+	twanFlagOperator uint8 = 0x08
+	twanFlagRelay    uint8 = 0x10
+)
+
+// NewTWANIdentifier creates a new TWANIdentifier IE.
+//
+// The optional fields are included only when they are not nil. relayIDType,
+// relayID and circuitID are included only when relayID is not nil.
+//
+// Use WithInstance(1) to put it as WLAN Location Information.
+func NewTWANIdentifier(ssid string, bssid net.HardwareAddr, civicAddr []byte, plmn *PLMN, opName []byte, relayIDType uint8, relayID, circuitID []byte) *IE {
+	fields := NewTWANIdentifierFields(ssid, bssid, civicAddr, plmn, opName, relayIDType, relayID, circuitID)
+	b, err := fields.Marshal()
+	if err != nil {
+		return nil
+	}
+
+	return New(TWANIdentifier, 0x00, b)
+}
+
+// TWANIdentifier returns TWANIdentifier in TWANIdentifierFields type if the type of IE matches.
+func (i *IE) TWANIdentifier() (*TWANIdentifierFields, error) {
+	switch i.Type {
+	case TWANIdentifier:
+		return ParseTWANIdentifierFields(i.Payload)
+	default:
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+}
+
+// SSID returns SSID in string if the type of IE matches.
+func (i *IE) SSID() (string, error) {
+	f, err := i.TWANIdentifier()
+	if err != nil {
+		return "", err
+	}
+
+	return f.SSID, nil
+}
+
+// MustSSID returns SSID in string, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustSSID() string {
+	v, _ := i.SSID()
+	return v
+}
+
+// TWANIdentifierFields is a set of fields in TWANIdentifier IE.
+type TWANIdentifierFields struct {
+	Flags             uint8
+	SSID              string
+	BSSID             net.HardwareAddr
+	CivicAddress      []byte
+	TWANPLMNID        *PLMN
+	TWANOperatorName  []byte
+	RelayIdentityType uint8
+	RelayIdentity     []byte
+	CircuitID         []byte
+}
+
+// NewTWANIdentifierFields creates a new TWANIdentifierFields.
+func NewTWANIdentifierFields(ssid string, bssid net.HardwareAddr, civicAddr []byte, plmn *PLMN, opName []byte, relayIDType uint8, relayID, circuitID []byte) *TWANIdentifierFields {
+	f := &TWANIdentifierFields{SSID: ssid}
+
+	if bssid != nil {
+		f.Flags |= twanFlagBSSID
+		f.BSSID = bssid
+	}
+	if civicAddr != nil {
+		f.Flags |= twanFlagCivic
+		f.CivicAddress = civicAddr
+	}
+	if plmn != nil {
+		f.Flags |= twanFlagPLMNID
+		f.TWANPLMNID = plmn
+	}
+	if opName != nil {
+		f.Flags |= twanFlagOperator
+		f.TWANOperatorName = opName
+	}
+	if relayID != nil {
+		f.Flags |= twanFlagRelay
+		f.RelayIdentityType = relayIDType
+		f.RelayIdentity = relayID
+		f.CircuitID = circuitID
+	}
+
+	return f
+}
+
+// HasBSSID reports whether BSSID is present in TWANIdentifierFields.
+func (f *TWANIdentifierFields) HasBSSID() bool {
+	return has1stBit(f.Flags)
+}
+
+// HasCivicAddress reports whether Civic Address is present in TWANIdentifierFields.
+func (f *TWANIdentifierFields) HasCivicAddress() bool {
+	return has2ndBit(f.Flags)
+}
+
+// HasTWANPLMNID reports whether TWAN PLMN-ID is present in TWANIdentifierFields.
+func (f *TWANIdentifierFields) HasTWANPLMNID() bool {
+	return has3rdBit(f.Flags)
+}
+
+// HasTWANOperatorName reports whether TWAN Operator Name is present in TWANIdentifierFields.
+func (f *TWANIdentifierFields) HasTWANOperatorName() bool {
+	return has4thBit(f.Flags)
+}
+
+// HasRelayIdentity reports whether Relay Identity and Circuit-ID are present in TWANIdentifierFields.
+func (f *TWANIdentifierFields) HasRelayIdentity() bool {
+	return has5thBit(f.Flags)
+}
+
+// Marshal serializes TWANIdentifierFields.
+func (f *TWANIdentifierFields) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// MarshalTo serializes TWANIdentifierFields.
+func (f *TWANIdentifierFields) MarshalTo(b []byte) error {
+	if len(b) < f.MarshalLen() {
+		return io.ErrUnexpectedEOF
+	}
+	if len(f.SSID) > 0xff || len(f.CivicAddress) > 0xff || len(f.TWANOperatorName) > 0xff ||
+		len(f.RelayIdentity) > 0xff || len(f.CircuitID) > 0xff {
+		return ErrMalformed
+	}
+
+	b[0] = f.Flags & 0x1f
+	b[1] = uint8(len(f.SSID))
+	offset := 2
+	copy(b[offset:], f.SSID)
+	offset += len(f.SSID)
+
+	if f.HasBSSID() {
+		if len(f.BSSID) != 6 {
+			return ErrMalformed
+		}
+		copy(b[offset:offset+6], f.BSSID)
+		offset += 6
+	}
+
+	if f.HasCivicAddress() {
+		b[offset] = uint8(len(f.CivicAddress))
+		copy(b[offset+1:], f.CivicAddress)
+		offset += 1 + len(f.CivicAddress)
+	}
+
+	if f.HasTWANPLMNID() {
+		if f.TWANPLMNID == nil {
+			return ErrMalformed
+		}
+		plmn, err := utils.EncodePLMN(f.TWANPLMNID.MCC, f.TWANPLMNID.MNC)
+		if err != nil {
+			return err
+		}
+		copy(b[offset:offset+3], plmn)
+		offset += 3
+	}
+
+	if f.HasTWANOperatorName() {
+		b[offset] = uint8(len(f.TWANOperatorName))
+		copy(b[offset+1:], f.TWANOperatorName)
+		offset += 1 + len(f.TWANOperatorName)
+	}
+
+	if f.HasRelayIdentity() {
+		b[offset] = f.RelayIdentityType
+		b[offset+1] = uint8(len(f.RelayIdentity))
+		copy(b[offset+2:], f.RelayIdentity)
+		offset += 2 + len(f.RelayIdentity)
+
+		b[offset] = uint8(len(f.CircuitID))
+		copy(b[offset+1:], f.CircuitID)
+	}
+
+	return nil
+}
+
+// ParseTWANIdentifierFields decodes TWANIdentifierFields.
+func ParseTWANIdentifierFields(b []byte) (*TWANIdentifierFields, error) {
+	f := &TWANIdentifierFields{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes into TWANIdentifierFields.
+func (f *TWANIdentifierFields) UnmarshalBinary(b []byte) error {
+	l := len(b)
+	if l < 2 {
+		return io.ErrUnexpectedEOF
+	}
+
+	f.Flags = b[0] & 0x1f
+	n := int(b[1])
+	offset := 2
+	if l < offset+n {
+		return io.ErrUnexpectedEOF
+	}
+	f.SSID = string(b[offset : offset+n])
+	offset += n
+
+	if f.HasBSSID() {
+		if l < offset+6 {
+			return io.ErrUnexpectedEOF
+		}
+		f.BSSID = net.HardwareAddr(b[offset : offset+6])
+		offset += 6
+	}
+
+	if f.HasCivicAddress() {
+		if l <= offset {
+			return io.ErrUnexpectedEOF
+		}
+		n = int(b[offset])
+		offset++
+		if l < offset+n {
+			return io.ErrUnexpectedEOF
+		}
+		f.CivicAddress = b[offset : offset+n]
+		offset += n
+	}
+
+	if f.HasTWANPLMNID() {
+		if l < offset+3 {
+			return io.ErrUnexpectedEOF
+		}
+		mcc, mnc, err := utils.DecodePLMN(b[offset : offset+3])
+		if err != nil {
+			return err
+		}
+		f.TWANPLMNID = &PLMN{MCC: mcc, MNC: mnc}
+		offset += 3
+	}
+
+	if f.HasTWANOperatorName() {
+		if l <= offset {
+			return io.ErrUnexpectedEOF
+		}
+		n = int(b[offset])
+		offset++
+		if l < offset+n {
+			return io.ErrUnexpectedEOF
+		}
+		f.TWANOperatorName = b[offset : offset+n]
+		offset += n
+	}
+
+	if f.HasRelayIdentity() {
+		if l < offset+2 {
+			return io.ErrUnexpectedEOF
+		}
+		f.RelayIdentityType = b[offset]
+		n = int(b[offset+1])
+		offset += 2
+		if l < offset+n {
+			return io.ErrUnexpectedEOF
+		}
+		f.RelayIdentity = b[offset : offset+n]
+		offset += n
+
+		if l <= offset {
+			return io.ErrUnexpectedEOF
+		}
+		n = int(b[offset])
+		offset++
+		if l < offset+n {
+			return io.ErrUnexpectedEOF
+		}
+		f.CircuitID = b[offset : offset+n]
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length of TWANIdentifierFields in int.
+func (f *TWANIdentifierFields) MarshalLen() int {
+	l := 2 + len(f.SSID)
+	if f.HasBSSID() {
+		l += 6
+	}
+	if f.HasCivicAddress() {
+		l += 1 + len(f.CivicAddress)
+	}
+	if f.HasTWANPLMNID() {
+		l += 3
+	}
+	if f.HasTWANOperatorName() {
+		l += 1 + len(f.TWANOperatorName)
+	}
+	if f.HasRelayIdentity() {
+		l += 2 + len(f.RelayIdentity) + 1 + len(f.CircuitID)
+	}
+	return l
+}
@@ -0,0 +1,68 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestTWANIdentifier(t *testing.T) {
+	bssid := net.HardwareAddr{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc}
+
+	cases := []struct {
+		description string
+		fields      *ie.TWANIdentifierFields
+	}{
+		{
+			"SSIDOnly",
+			ie.NewTWANIdentifierFields("ssid", nil, nil, nil, nil, 0, nil, nil),
+		}, {
+			"WithBSSID",
+			ie.NewTWANIdentifierFields("ssid", bssid, nil, nil, nil, 0, nil, nil),
+		}, {
+			"WithPLMNID",
+			ie.NewTWANIdentifierFields("ssid", nil, nil, &ie.PLMN{MCC: "123", MNC: "456"}, nil, 0, nil, nil),
+		}, {
+			"All",
+			ie.NewTWANIdentifierFields(
+				"ssid", bssid, []byte{0xde, 0xad}, &ie.PLMN{MCC: "123", MNC: "45"}, []byte("op"),
+				gtpv2.RelayIdentityTypeIPAddress, []byte{0x7f, 0x00, 0x00, 0x01}, []byte{0x01, 0x02},
+			),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			i := ie.NewTWANIdentifier(
+				c.fields.SSID, c.fields.BSSID, c.fields.CivicAddress, c.fields.TWANPLMNID,
+				c.fields.TWANOperatorName, c.fields.RelayIdentityType, c.fields.RelayIdentity, c.fields.CircuitID,
+			)
+			b, err := i.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := ie.Parse(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := parsed.TWANIdentifier()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(c.fields, got); diff != "" {
+				t.Error(diff)
+			}
+			if ssid := parsed.MustSSID(); ssid != "ssid" {
+				t.Errorf("got SSID %s, want ssid", ssid)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@
 
 package ie
 
+import "time"
+
 func has8thBit(f uint8) bool {
 	return (f&0x80)>>7 == 1
 }
@@ -35,3 +37,14 @@ func has2ndBit(f uint8) bool {
 func has1stBit(f uint8) bool {
 	return (f & 0x01) == 1
 }
+
+// timeToNTPSeconds converts the time given into the seconds since 1900-01-01,
+// which is the integer part of the NTP timestamp.
+func timeToNTPSeconds(ts time.Time) uint32 {
+	return uint32(uint64(ts.Sub(time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC))) / uint64(time.Second))
+}
+
+// ntpSecondsToTime converts the seconds since 1900-01-01 into time.Time in UTC.
+func ntpSecondsToTime(secs uint32) time.Time {
+	return time.Unix(int64(secs)-2208988800, 0).UTC()
+}
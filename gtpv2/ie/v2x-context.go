@@ -0,0 +1,52 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewV2XContext creates a new V2XContext IE.
+//
+// lteAuthorized and nrAuthorized should be ServicesAuthorized IEs, lteAMBR and
+// nrAMBR should be BitRate IEs, and pc5QoS should be a PC5QoSParameters IE.
+// Any of them can be nil if not present.
+// The copies of nrAuthorized and nrAMBR with the instance set to 1 are used to
+// distinguish them from the LTE ones, and the IEs given are left unchanged.
+func NewV2XContext(lteAuthorized, nrAuthorized, lteAMBR, nrAMBR, pc5QoS *IE) *IE {
+	if nrAuthorized != nil {
+		c := *nrAuthorized
+		nrAuthorized = c.WithInstance(1)
+	}
+	if nrAMBR != nil {
+		c := *nrAMBR
+		nrAMBR = c.WithInstance(1)
+	}
+
+	var ies []*IE
+	for _, i := range []*IE{lteAuthorized, nrAuthorized, lteAMBR, nrAMBR, pc5QoS} {
+		if i != nil {
+			ies = append(ies, i)
+		}
+	}
+	return newGroupedIE(V2XContext, ies...)
+}
+
+// V2XContext returns the IEs above V2XContext if the type of IE matches.
+func (i *IE) V2XContext() ([]*IE, error) {
+	if i.Type != V2XContext {
+		return nil, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return ParseMultiIEs(i.Payload)
+}
+
+// MustV2XContext returns V2XContext in []*IE, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustV2XContext() []*IE {
+	v, _ := i.V2XContext()
+	return v
+}
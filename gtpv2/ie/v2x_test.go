@@ -0,0 +1,83 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+func TestV2XIEs(t *testing.T) {
+	t.Run("PC5QoSFlow", func(t *testing.T) {
+		got, err := ie.NewPC5QoSFlow(21, 1000, 2000, 3).PC5QoSFlow()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(ie.NewPC5QoSFlowFields(21, 1000, 2000, 3), got); diff != "" {
+			t.Error(diff)
+		}
+		if !got.HasRange() {
+			t.Error("Range should be present")
+		}
+	})
+
+	t.Run("V2XContext", func(t *testing.T) {
+		nrAMBR := ie.NewBitRate(200000)
+		v2x := ie.NewV2XContext(
+			ie.NewServicesAuthorized(gtpv2.ServicesAuthorizedAuthorized, gtpv2.ServicesAuthorizedNotAuthorized),
+			nil,
+			ie.NewBitRate(100000),
+			nrAMBR,
+			ie.NewPC5QoSParameters(nil, ie.NewPC5QoSFlow(21, 1000, 2000, 0)),
+		)
+		if got := nrAMBR.Instance(); got != 0 {
+			t.Errorf("the IE given should not be changed, got instance %d", got)
+		}
+
+		b, err := v2x.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := ie.Parse(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ies, err := parsed.V2XContext()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, i := range ies {
+			switch i.Type {
+			case ie.ServicesAuthorized:
+				if v, p := i.MustVehicleUEAuthorized(), i.MustPedestrianUEAuthorized(); v != gtpv2.ServicesAuthorizedAuthorized || p != gtpv2.ServicesAuthorizedNotAuthorized {
+					t.Errorf("got vehicle %d, pedestrian %d", v, p)
+				}
+			case ie.BitRate:
+				want := map[uint8]uint32{0: 100000, 1: 200000}[i.Instance()]
+				if got := i.MustBitRate(); got != want {
+					t.Errorf("got bit rate %d in instance %d, want %d", got, i.Instance(), want)
+				}
+			case ie.PC5QoSParameters:
+				flows := i.MustPC5QoSParameters()
+				if len(flows) != 1 {
+					t.Fatalf("got %d IEs in PC5 QoS Parameters, want 1", len(flows))
+				}
+				f, err := flows[0].PC5QoSFlow()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if f.PQI != 21 || f.HasRange() {
+					t.Errorf("got PQI %d, has Range %v", f.PQI, f.HasRange())
+				}
+			default:
+				t.Errorf("unexpected IE in V2X Context: %s", i)
+			}
+		}
+	})
+}
@@ -0,0 +1,53 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package ie
+
+import "io"
+
+// NewWLANOffloadabilityIndication creates a new WLANOffloadabilityIndication IE.
+func NewWLANOffloadabilityIndication(eutran, utran uint8) *IE {
+	i := New(WLANOffloadabilityIndication, 0x00, make([]byte, 1))
+	i.Payload[0] |= (eutran << 1 & 0x02) | (utran & 0x01)
+	return i
+}
+
+// WLANOffloadabilityIndication returns WLANOffloadabilityIndication in uint8 if the type of IE matches.
+func (i *IE) WLANOffloadabilityIndication() (uint8, error) {
+	if i.Type != WLANOffloadabilityIndication {
+		return 0, &InvalidTypeError{Type: i.Type}
+	}
+	if len(i.Payload) < 1 {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	return i.Payload[0], nil
+}
+
+// MustWLANOffloadabilityIndication returns WLANOffloadabilityIndication in uint8, ignoring errors.
+// This should only be used if it is assured to have the value.
+func (i *IE) MustWLANOffloadabilityIndication() uint8 {
+	v, _ := i.WLANOffloadabilityIndication()
+	return v
+}
+
+// HasUTRANIndication reports whether an IE has UTRAN Indication bit.
+func (i *IE) HasUTRANIndication() bool {
+	v, err := i.WLANOffloadabilityIndication()
+	if err != nil {
+		return false
+	}
+
+	return has1stBit(v)
+}
+
+// HasEUTRANIndication reports whether an IE has E-UTRAN Indication bit.
+func (i *IE) HasEUTRANIndication() bool {
+	v, err := i.WLANOffloadabilityIndication()
+	if err != nil {
+		return false
+	}
+
+	return has2ndBit(v)
+}
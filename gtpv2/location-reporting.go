@@ -0,0 +1,338 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+// locationReporting is the state of location change reporting requested by the PGW.
+type locationReporting struct {
+	action uint8
+	uli    *ie.UserLocationInformationFields
+	pras   map[uint32]*presenceReportingArea
+}
+
+// presenceReportingArea is a Presence Reporting Area and whether the UE is in it.
+type presenceReportingArea struct {
+	*ie.PresenceReportingAreaActionFields
+	inside bool
+}
+
+// SetLocationReporting records the Change Reporting Action and Presence Reporting
+// Area Actions contained in msg, which should be Create Session Response, Create
+// Bearer Request or Update Bearer Request sent by the PGW.
+//
+// The ones that are not contained in msg are left unchanged. Use UpdateLocation to
+// know when to send Change Notification Request afterwards.
+func (s *Session) SetLocationReporting(msg message.Message) error {
+	var cra *ie.IE
+	var praActions []*ie.IE
+	switch m := msg.(type) {
+	case *message.CreateSessionResponse:
+		cra, praActions = m.ChangeReportingAction, m.PresenceReportingAreaAction
+	case *message.CreateBearerRequest:
+		cra, praActions = m.ChangeReportingAction, m.PresenceReportingAreaAction
+	case *message.UpdateBearerRequest:
+		cra, praActions = m.ChangeReportingAction, m.PresenceReportingAction
+	default:
+		return &UnexpectedTypeError{Msg: msg}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.locationReporting == nil {
+		s.locationReporting = &locationReporting{pras: map[uint32]*presenceReportingArea{}}
+	}
+	lr := s.locationReporting
+
+	if cra != nil {
+		action, err := cra.ChangeReportingAction()
+		if err != nil {
+			return err
+		}
+		lr.action = action
+	}
+
+	for _, i := range praActions {
+		f, err := i.PresenceReportingAreaAction()
+		if err != nil {
+			return err
+		}
+
+		switch f.Action {
+		case PresenceReportingAreaActionStartReporting:
+			pra := &presenceReportingArea{PresenceReportingAreaActionFields: f}
+			if lr.uli != nil {
+				pra.inside = pra.contains(lr.uli)
+			}
+			lr.pras[f.PRAID] = pra
+		case PresenceReportingAreaActionStopReporting:
+			delete(lr.pras, f.PRAID)
+		case PresenceReportingAreaActionModifyPresenceReportingAreaElements:
+			pra, ok := lr.pras[f.PRAID]
+			if !ok {
+				continue
+			}
+			pra.PresenceReportingAreaActionFields = f
+		}
+	}
+
+	return nil
+}
+
+// ChangeReportingAction returns the Change Reporting Action currently requested
+// for the Session.
+func (s *Session) ChangeReportingAction() uint8 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.locationReporting == nil {
+		return ChangeReportingActionStopReporting
+	}
+	return s.locationReporting.action
+}
+
+// UpdateLocation updates the location of the subscriber with uli, which should be
+// a UserLocationInformation IE, and reports whether a Change Notification Request
+// should be sent to the PGW to notify the change.
+//
+// It returns true if the location has changed in the way the Change Reporting
+// Action requires to be reported, or if the UE has entered or left any of the
+// Presence Reporting Areas requested to be reported. The first ULI given is only
+// recorded, as the PGW is expected to know it.
+func (s *Session) UpdateLocation(uli *ie.IE) (bool, error) {
+	f, err := uli.UserLocationInformation()
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Subscriber != nil && s.Location != nil {
+		s.Location.updateWithULI(f)
+	}
+
+	if s.locationReporting == nil {
+		s.locationReporting = &locationReporting{pras: map[uint32]*presenceReportingArea{}}
+	}
+	lr := s.locationReporting
+
+	prev := lr.uli
+	lr.uli = f
+
+	var changed bool
+	for _, pra := range lr.pras {
+		inside := pra.contains(f)
+		if inside != pra.inside {
+			pra.inside = inside
+			changed = true
+		}
+	}
+	if prev == nil {
+		return false, nil
+	}
+
+	return changed || locationChanged(lr.action, prev, f), nil
+}
+
+// PresenceReportingAreaInformation returns PresenceReportingAreaInformation IEs
+// that indicate whether the UE is inside or outside each Presence Reporting Area
+// requested to be reported, to be set in Change Notification Request.
+func (s *Session) PresenceReportingAreaInformation() []*ie.IE {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.locationReporting == nil {
+		return nil
+	}
+
+	var ies []*ie.IE
+	for id, pra := range s.locationReporting.pras {
+		var inapra uint8
+		if pra.INAPRA {
+			inapra = 1
+		}
+		if pra.inside {
+			ies = append(ies, ie.NewPresenceReportingAreaInformation(id, inapra, 0, 0, 1))
+		} else {
+			ies = append(ies, ie.NewPresenceReportingAreaInformation(id, inapra, 0, 1, 0))
+		}
+	}
+	return ies
+}
+
+// locationChanged reports whether the change from prev to cur should be reported
+// with the Change Reporting Action given.
+func locationChanged(action uint8, prev, cur *ie.UserLocationInformationFields) bool {
+	var (
+		cgiSAI = !equalCGI(prev.CGI, cur.CGI) || !equalSAI(prev.SAI, cur.SAI)
+		rai    = !equalRAI(prev.RAI, cur.RAI)
+		tai    = !equalTAI(prev.TAI, cur.TAI)
+		ecgi   = !equalECGI(prev.ECGI, cur.ECGI)
+		menbi  = !equalMENBI(prev.MENBI, cur.MENBI) || !equalEMENBI(prev.EMENBI, cur.EMENBI)
+	)
+
+	switch action {
+	case ChangeReportingActionStartReportingCGISAI:
+		return cgiSAI
+	case ChangeReportingActionStartReportingRAI:
+		return rai
+	case ChangeReportingActionStartReportingTAI:
+		return tai
+	case ChangeReportingActionStartReportingECGI:
+		return ecgi
+	case ChangeReportingActionStartReportingCGISAIAndRAI:
+		return cgiSAI || rai
+	case ChangeReportingActionStartReportingTAIAndECGI:
+		return tai || ecgi
+	case ChangeReportingActionStartReportingMacroENBIDAndExtendedMacroENBID:
+		return menbi
+	case ChangeReportingActionStartReportingTAIMacroENBIDAndExtendedMacroENBID:
+		return tai || menbi
+	default:
+		return false
+	}
+}
+
+// contains reports whether the location in uli is in the Presence Reporting Area.
+func (p *presenceReportingArea) contains(uli *ie.UserLocationInformationFields) bool {
+	for _, v := range p.TAIs {
+		if equalTAI(v, uli.TAI) {
+			return true
+		}
+	}
+	for _, v := range p.MacroENBIDs {
+		if equalMENBI(v, uli.MENBI) {
+			return true
+		}
+	}
+	for _, v := range p.HomeENBIDs {
+		if uli.ECGI != nil && equalPLMN(v.PLMN, uli.ECGI.PLMN) && v.HENBI == uli.ECGI.ECI {
+			return true
+		}
+	}
+	for _, v := range p.ECGIs {
+		if equalECGI(v, uli.ECGI) {
+			return true
+		}
+	}
+	for _, v := range p.RAIs {
+		if equalRAI(v, uli.RAI) {
+			return true
+		}
+	}
+	for _, v := range p.SAIs {
+		if equalSAI(v, uli.SAI) {
+			return true
+		}
+	}
+	for _, v := range p.CGIs {
+		if equalCGI(v, uli.CGI) {
+			return true
+		}
+	}
+	for _, v := range p.ExtendedMacroENBIDs {
+		if equalEMENBI(v, uli.EMENBI) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateWithULI updates the Location with the values in the ULI given.
+func (l *Location) updateWithULI(uli *ie.UserLocationInformationFields) {
+	var plmn *ie.PLMN
+	if uli.CGI != nil {
+		plmn = uli.CGI.PLMN
+		l.LAC, l.CI = uli.CGI.LAC, uli.CGI.CI
+	}
+	if uli.SAI != nil {
+		plmn = uli.SAI.PLMN
+		l.LAC, l.SAI = uli.SAI.LAC, uli.SAI.SAC
+	}
+	if uli.RAI != nil {
+		plmn = uli.RAI.PLMN
+		l.LAC, l.RAI = uli.RAI.LAC, uli.RAI.RAC
+	}
+	if uli.TAI != nil {
+		plmn = uli.TAI.PLMN
+		l.TAI = uli.TAI.TAC
+	}
+	if uli.ECGI != nil {
+		plmn = uli.ECGI.PLMN
+		l.ECI = uli.ECGI.ECI
+	}
+	if uli.MENBI != nil {
+		plmn = uli.MENBI.PLMN
+		l.MeNBI = uli.MENBI.MENBI
+	}
+	if uli.EMENBI != nil {
+		plmn = uli.EMENBI.PLMN
+		l.EMeNBI = uli.EMENBI.EMENBI
+	}
+	if plmn != nil {
+		l.MCC, l.MNC = plmn.MCC, plmn.MNC
+	}
+}
+
+func equalPLMN(a, b *ie.PLMN) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.MCC == b.MCC && a.MNC == b.MNC
+}
+
+func equalCGI(a, b *ie.CGI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalPLMN(a.PLMN, b.PLMN) && a.LAC == b.LAC && a.CI == b.CI
+}
+
+func equalSAI(a, b *ie.SAI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalPLMN(a.PLMN, b.PLMN) && a.LAC == b.LAC && a.SAC == b.SAC
+}
+
+func equalRAI(a, b *ie.RAI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalPLMN(a.PLMN, b.PLMN) && a.LAC == b.LAC && a.RAC == b.RAC
+}
+
+func equalTAI(a, b *ie.TAI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalPLMN(a.PLMN, b.PLMN) && a.TAC == b.TAC
+}
+
+func equalECGI(a, b *ie.ECGI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalPLMN(a.PLMN, b.PLMN) && a.ECI == b.ECI
+}
+
+func equalMENBI(a, b *ie.MENBI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalPLMN(a.PLMN, b.PLMN) && a.MENBI == b.MENBI
+}
+
+func equalEMENBI(a, b *ie.EMENBI) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equalPLMN(a.PLMN, b.PLMN) && a.EMENBI == b.EMENBI
+}
@@ -0,0 +1,132 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+func newULI(tac uint16, eci uint32) *ie.IE {
+	return ie.NewUserLocationInformationStruct(
+		nil, nil, nil, ie.NewTAI("123", "45", tac), ie.NewECGI("123", "45", eci), nil, nil, nil,
+	)
+}
+
+func TestLocationReporting(t *testing.T) {
+	cases := []struct {
+		description string
+		action      uint8
+		prev, cur   *ie.IE
+		want        bool
+	}{
+		{"StopReporting", gtpv2.ChangeReportingActionStopReporting, newULI(1, 1), newULI(2, 2), false},
+		{"TAI/Changed", gtpv2.ChangeReportingActionStartReportingTAI, newULI(1, 1), newULI(2, 1), true},
+		{"TAI/ECGIChanged", gtpv2.ChangeReportingActionStartReportingTAI, newULI(1, 1), newULI(1, 2), false},
+		{"ECGI/Changed", gtpv2.ChangeReportingActionStartReportingECGI, newULI(1, 1), newULI(1, 2), true},
+		{"TAIAndECGI/NotChanged", gtpv2.ChangeReportingActionStartReportingTAIAndECGI, newULI(1, 1), newULI(1, 1), false},
+		{"TAIAndECGI/Changed", gtpv2.ChangeReportingActionStartReportingTAIAndECGI, newULI(1, 1), newULI(1, 2), true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.description, func(t *testing.T) {
+			sess := gtpv2.NewSession(&net.UDPAddr{}, &gtpv2.Subscriber{Location: &gtpv2.Location{}})
+			if err := sess.SetLocationReporting(message.NewCreateSessionResponse(
+				0, 0, ie.NewChangeReportingAction(c.action),
+			)); err != nil {
+				t.Fatal(err)
+			}
+			if got := sess.ChangeReportingAction(); got != c.action {
+				t.Errorf("got action %d, want %d", got, c.action)
+			}
+
+			notify, err := sess.UpdateLocation(c.prev)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if notify {
+				t.Error("should not notify with the first ULI")
+			}
+
+			notify, err = sess.UpdateLocation(c.cur)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if notify != c.want {
+				t.Errorf("got %v, want %v", notify, c.want)
+			}
+
+			want, err := c.cur.UserLocationInformation()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sess.Location.TAI != want.TAI.TAC || sess.Location.ECI != want.ECGI.ECI {
+				t.Errorf("Location not updated: %+v", sess.Location)
+			}
+		})
+	}
+}
+
+func TestPresenceReportingArea(t *testing.T) {
+	sess := gtpv2.NewSession(&net.UDPAddr{}, &gtpv2.Subscriber{})
+	if _, err := sess.UpdateLocation(newULI(1, 1)); err != nil {
+		t.Fatal(err)
+	}
+
+	pra := ie.NewPresenceReportingAreaActionFields(gtpv2.PresenceReportingAreaActionStartReporting, 1)
+	pra.TAIs = []*ie.TAI{ie.NewTAI("123", "45", 2)}
+	if err := sess.SetLocationReporting(message.NewUpdateBearerRequest(
+		0, 0, ie.NewPresenceReportingAreaAction(pra),
+	)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		description string
+		uli         *ie.IE
+		notify      bool
+		inside      bool
+	}{
+		{"StayOutside", newULI(1, 2), false, false},
+		{"Enter", newULI(2, 2), true, true},
+		{"StayInside", newULI(2, 3), false, true},
+		{"Leave", newULI(3, 3), true, false},
+	} {
+		notify, err := sess.UpdateLocation(c.uli)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if notify != c.notify {
+			t.Errorf("%s: got %v, want %v", c.description, notify, c.notify)
+		}
+
+		info := sess.PresenceReportingAreaInformation()
+		if len(info) != 1 {
+			t.Fatalf("%s: got %d PRA Information, want 1", c.description, len(info))
+		}
+		if info[0].MustPRAID() != 1 || info[0].HasIPRA() != c.inside || info[0].HasOPRA() == c.inside {
+			t.Errorf("%s: unexpected PRA Information: %v", c.description, info[0])
+		}
+	}
+
+	// stop reporting
+	if err := sess.SetLocationReporting(message.NewCreateBearerRequest(
+		0, 0, ie.NewPresenceReportingAreaAction(
+			ie.NewPresenceReportingAreaActionFields(gtpv2.PresenceReportingAreaActionStopReporting, 1),
+		),
+	)); err != nil {
+		t.Fatal(err)
+	}
+	if info := sess.PresenceReportingAreaInformation(); len(info) != 0 {
+		t.Errorf("got %d PRA Information, want 0", len(info))
+	}
+	if notify, _ := sess.UpdateLocation(newULI(2, 2)); notify {
+		t.Error("should not notify after stopped reporting")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// AlertMMENotification is a AlertMMENotification Header and its IEs above.
+type AlertMMENotification struct {
+	*Header
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewAlertMMENotification creates a new AlertMMENotification.
+func NewAlertMMENotification(teid, seq uint32, ies ...*ie.IE) *AlertMMENotification {
+	a := &AlertMMENotification{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeAlertMMENotification, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.PrivateExtension:
+			a.PrivateExtension = i
+		default:
+			a.AdditionalIEs = append(a.AdditionalIEs, i)
+		}
+	}
+
+	a.SetLength()
+	return a
+}
+
+// Marshal serializes AlertMMENotification into bytes.
+func (a *AlertMMENotification) Marshal() ([]byte, error) {
+	b := make([]byte, a.MarshalLen())
+	if err := a.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes AlertMMENotification into bytes.
+func (a *AlertMMENotification) MarshalTo(b []byte) error {
+	if a.Header.Payload != nil {
+		a.Header.Payload = nil
+	}
+	a.Header.Payload = make([]byte, a.MarshalLen()-a.Header.MarshalLen())
+
+	offset := 0
+	if ie := a.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(a.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range a.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(a.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	a.Header.SetLength()
+	return a.Header.MarshalTo(b)
+}
+
+// ParseAlertMMENotification decodes given bytes as AlertMMENotification.
+func ParseAlertMMENotification(b []byte) (*AlertMMENotification, error) {
+	a := &AlertMMENotification{}
+	if err := a.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// UnmarshalBinary decodes given bytes as AlertMMENotification.
+func (a *AlertMMENotification) UnmarshalBinary(b []byte) error {
+	var err error
+	a.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(a.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(a.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.PrivateExtension:
+			a.PrivateExtension = i
+		default:
+			a.AdditionalIEs = append(a.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (a *AlertMMENotification) MarshalLen() int {
+	l := a.Header.MarshalLen() - len(a.Header.Payload)
+
+	if ie := a.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range a.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (a *AlertMMENotification) SetLength() {
+	a.Header.Length = uint16(a.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (a *AlertMMENotification) MessageTypeName() string {
+	return "Alert MME Notification"
+}
+
+// TEID returns the TEID in uint32.
+func (a *AlertMMENotification) TEID() uint32 {
+	return a.Header.teid()
+}
@@ -0,0 +1,432 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// BearerResourceCommand is a BearerResourceCommand Header and its IEs above.
+type BearerResourceCommand struct {
+	*Header
+	LinkedEBI                         *ie.IE
+	PTI                               *ie.IE
+	FlowQoS                           *ie.IE
+	TAD                               *ie.IE
+	RATType                           *ie.IE
+	ServingNetwork                    *ie.IE
+	ULI                               *ie.IE
+	EBI                               *ie.IE
+	IndicationFlags                   *ie.IE
+	S4USGSNFTEID                      *ie.IE
+	S12RNCFTEID                       *ie.IE
+	SenderFTEIDC                      *ie.IE
+	PCO                               *ie.IE
+	SignallingPriorityIndication      *ie.IE
+	MMESGSNOverloadControlInformation *ie.IE
+	SGWOverloadControlInformation     *ie.IE
+	NBIFOMContainer                   *ie.IE
+	ExtendedPCO                       *ie.IE
+	PrivateExtension                  *ie.IE
+	AdditionalIEs                     []*ie.IE
+}
+
+// NewBearerResourceCommand creates a new BearerResourceCommand.
+func NewBearerResourceCommand(teid, seq uint32, ies ...*ie.IE) *BearerResourceCommand {
+	r := &BearerResourceCommand{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeBearerResourceCommand, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.EPSBearerID:
+			switch i.Instance() {
+			case 0:
+				r.LinkedEBI = i
+			case 1:
+				r.EBI = i
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.ProcedureTransactionID:
+			r.PTI = i
+		case ie.FlowQoS:
+			r.FlowQoS = i
+		case ie.TrafficAggregateDescription:
+			r.TAD = i
+		case ie.RATType:
+			r.RATType = i
+		case ie.ServingNetwork:
+			r.ServingNetwork = i
+		case ie.UserLocationInformation:
+			r.ULI = i
+		case ie.Indication:
+			r.IndicationFlags = i
+		case ie.FullyQualifiedTEID:
+			switch i.Instance() {
+			case 0:
+				r.S4USGSNFTEID = i
+			case 1:
+				r.S12RNCFTEID = i
+			case 2:
+				r.SenderFTEIDC = i
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.ProtocolConfigurationOptions:
+			r.PCO = i
+		case ie.SignallingPriorityIndication:
+			r.SignallingPriorityIndication = i
+		case ie.OverloadControlInformation:
+			switch i.Instance() {
+			case 0:
+				r.MMESGSNOverloadControlInformation = i
+			case 1:
+				r.SGWOverloadControlInformation = i
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.FContainer:
+			r.NBIFOMContainer = i
+		case ie.ExtendedProtocolConfigurationOptions:
+			r.ExtendedPCO = i
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	r.SetLength()
+	return r
+}
+
+// Marshal serializes BearerResourceCommand into bytes.
+func (r *BearerResourceCommand) Marshal() ([]byte, error) {
+	b := make([]byte, r.MarshalLen())
+	if err := r.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes BearerResourceCommand into bytes.
+func (r *BearerResourceCommand) MarshalTo(b []byte) error {
+	if r.Header.Payload != nil {
+		r.Header.Payload = nil
+	}
+	r.Header.Payload = make([]byte, r.MarshalLen()-r.Header.MarshalLen())
+
+	offset := 0
+	if ie := r.LinkedEBI; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.PTI; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.FlowQoS; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.TAD; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.RATType; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.ServingNetwork; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.ULI; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.EBI; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.IndicationFlags; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.S4USGSNFTEID; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.S12RNCFTEID; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.SenderFTEIDC; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.PCO; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.SignallingPriorityIndication; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.MMESGSNOverloadControlInformation; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.SGWOverloadControlInformation; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.NBIFOMContainer; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.ExtendedPCO; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(r.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	r.Header.SetLength()
+	return r.Header.MarshalTo(b)
+}
+
+// ParseBearerResourceCommand decodes given bytes as BearerResourceCommand.
+func ParseBearerResourceCommand(b []byte) (*BearerResourceCommand, error) {
+	r := &BearerResourceCommand{}
+	if err := r.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UnmarshalBinary decodes given bytes as BearerResourceCommand.
+func (r *BearerResourceCommand) UnmarshalBinary(b []byte) error {
+	var err error
+	r.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(r.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(r.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.EPSBearerID:
+			switch i.Instance() {
+			case 0:
+				r.LinkedEBI = i
+			case 1:
+				r.EBI = i
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.ProcedureTransactionID:
+			r.PTI = i
+		case ie.FlowQoS:
+			r.FlowQoS = i
+		case ie.TrafficAggregateDescription:
+			r.TAD = i
+		case ie.RATType:
+			r.RATType = i
+		case ie.ServingNetwork:
+			r.ServingNetwork = i
+		case ie.UserLocationInformation:
+			r.ULI = i
+		case ie.Indication:
+			r.IndicationFlags = i
+		case ie.FullyQualifiedTEID:
+			switch i.Instance() {
+			case 0:
+				r.S4USGSNFTEID = i
+			case 1:
+				r.S12RNCFTEID = i
+			case 2:
+				r.SenderFTEIDC = i
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.ProtocolConfigurationOptions:
+			r.PCO = i
+		case ie.SignallingPriorityIndication:
+			r.SignallingPriorityIndication = i
+		case ie.OverloadControlInformation:
+			switch i.Instance() {
+			case 0:
+				r.MMESGSNOverloadControlInformation = i
+			case 1:
+				r.SGWOverloadControlInformation = i
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.FContainer:
+			r.NBIFOMContainer = i
+		case ie.ExtendedProtocolConfigurationOptions:
+			r.ExtendedPCO = i
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (r *BearerResourceCommand) MarshalLen() int {
+	l := r.Header.MarshalLen() - len(r.Header.Payload)
+
+	if ie := r.LinkedEBI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.PTI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.FlowQoS; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.TAD; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.RATType; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.ServingNetwork; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.ULI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.EBI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.IndicationFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.S4USGSNFTEID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.S12RNCFTEID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.SenderFTEIDC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.PCO; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.SignallingPriorityIndication; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.MMESGSNOverloadControlInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.SGWOverloadControlInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.NBIFOMContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.ExtendedPCO; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (r *BearerResourceCommand) SetLength() {
+	r.Header.Length = uint16(r.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (r *BearerResourceCommand) MessageTypeName() string {
+	return "Bearer Resource Command"
+}
+
+// TEID returns the TEID in uint32.
+func (r *BearerResourceCommand) TEID() uint32 {
+	return r.Header.teid()
+}
@@ -0,0 +1,61 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestBearerResourceCommand(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewBearerResourceCommand(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewEPSBearerID(0x05),
+				ie.NewProcedureTransactionID(0x01),
+				ie.NewFlowQoS(0x01, 0x11111111, 0x22222222, 0x33333333, 0x44444444),
+				ie.NewTrafficAggregateDescriptionNoTFTOperation(),
+				ie.NewRATType(gtpv2.RATTypeEUTRAN),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS11MMEGTPC, 0xffffffff, "1.1.1.1", "").WithInstance(2),
+				ie.NewSignallingPriorityIndication(1),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x44, 0x00, 0x47, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// Linked EBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+				// PTI
+				0x64, 0x00, 0x01, 0x00, 0x01,
+				// Flow QoS
+				0x51, 0x00, 0x15, 0x00, 0x01,
+				0x00, 0x11, 0x11, 0x11, 0x11, 0x00, 0x22, 0x22, 0x22, 0x22,
+				0x00, 0x33, 0x33, 0x33, 0x33, 0x00, 0x44, 0x44, 0x44, 0x44,
+				// TAD
+				0x55, 0x00, 0x01, 0x00, 0xc0,
+				// RAT Type
+				0x52, 0x00, 0x01, 0x00, 0x06,
+				// Sender F-TEID for Control Plane
+				0x57, 0x00, 0x09, 0x02, 0x8a, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// Signalling Priority Indication
+				0x9d, 0x00, 0x01, 0x00, 0x01,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseBearerResourceCommand(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
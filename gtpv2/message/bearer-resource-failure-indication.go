@@ -0,0 +1,272 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// BearerResourceFailureIndication is a BearerResourceFailureIndication Header and its IEs above.
+type BearerResourceFailureIndication struct {
+	*Header
+	Cause                         *ie.IE
+	LinkedEBI                     *ie.IE
+	PTI                           *ie.IE
+	IndicationFlags               *ie.IE
+	PGWOverloadControlInformation *ie.IE
+	SGWOverloadControlInformation *ie.IE
+	Recovery                      *ie.IE
+	NBIFOMContainer               *ie.IE
+	PrivateExtension              *ie.IE
+	AdditionalIEs                 []*ie.IE
+}
+
+// NewBearerResourceFailureIndication creates a new BearerResourceFailureIndication.
+func NewBearerResourceFailureIndication(teid, seq uint32, ies ...*ie.IE) *BearerResourceFailureIndication {
+	r := &BearerResourceFailureIndication{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeBearerResourceFailureIndication, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			r.Cause = i
+		case ie.EPSBearerID:
+			r.LinkedEBI = i
+		case ie.ProcedureTransactionID:
+			r.PTI = i
+		case ie.Indication:
+			r.IndicationFlags = i
+		case ie.OverloadControlInformation:
+			switch i.Instance() {
+			case 0:
+				r.PGWOverloadControlInformation = i
+			case 1:
+				r.SGWOverloadControlInformation = i
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.Recovery:
+			r.Recovery = i
+		case ie.FContainer:
+			r.NBIFOMContainer = i
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	r.SetLength()
+	return r
+}
+
+// Marshal serializes BearerResourceFailureIndication into bytes.
+func (r *BearerResourceFailureIndication) Marshal() ([]byte, error) {
+	b := make([]byte, r.MarshalLen())
+	if err := r.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes BearerResourceFailureIndication into bytes.
+func (r *BearerResourceFailureIndication) MarshalTo(b []byte) error {
+	if r.Header.Payload != nil {
+		r.Header.Payload = nil
+	}
+	r.Header.Payload = make([]byte, r.MarshalLen()-r.Header.MarshalLen())
+
+	offset := 0
+	if ie := r.Cause; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.LinkedEBI; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.PTI; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.IndicationFlags; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.PGWOverloadControlInformation; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.SGWOverloadControlInformation; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.Recovery; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.NBIFOMContainer; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(r.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	r.Header.SetLength()
+	return r.Header.MarshalTo(b)
+}
+
+// ParseBearerResourceFailureIndication decodes given bytes as BearerResourceFailureIndication.
+func ParseBearerResourceFailureIndication(b []byte) (*BearerResourceFailureIndication, error) {
+	r := &BearerResourceFailureIndication{}
+	if err := r.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UnmarshalBinary decodes given bytes as BearerResourceFailureIndication.
+func (r *BearerResourceFailureIndication) UnmarshalBinary(b []byte) error {
+	var err error
+	r.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(r.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(r.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			r.Cause = i
+		case ie.EPSBearerID:
+			r.LinkedEBI = i
+		case ie.ProcedureTransactionID:
+			r.PTI = i
+		case ie.Indication:
+			r.IndicationFlags = i
+		case ie.OverloadControlInformation:
+			switch i.Instance() {
+			case 0:
+				r.PGWOverloadControlInformation = i
+			case 1:
+				r.SGWOverloadControlInformation = i
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.Recovery:
+			r.Recovery = i
+		case ie.FContainer:
+			r.NBIFOMContainer = i
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (r *BearerResourceFailureIndication) MarshalLen() int {
+	l := r.Header.MarshalLen() - len(r.Header.Payload)
+
+	if ie := r.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.LinkedEBI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.PTI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.IndicationFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.PGWOverloadControlInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.SGWOverloadControlInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.NBIFOMContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (r *BearerResourceFailureIndication) SetLength() {
+	r.Header.Length = uint16(r.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (r *BearerResourceFailureIndication) MessageTypeName() string {
+	return "Bearer Resource Failure Indication"
+}
+
+// TEID returns the TEID in uint32.
+func (r *BearerResourceFailureIndication) TEID() uint32 {
+	return r.Header.teid()
+}
@@ -6,6 +6,7 @@ package message_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/wmnsk/go-gtp/gtpv2"
 	"github.com/wmnsk/go-gtp/gtpv2/ie"
@@ -91,6 +92,38 @@ func TestChangeNotificationRequest(t *testing.T) {
 				0xff, 0x00, 0x06, 0x00, 0x28, 0xaf, 0xde, 0xad, 0xbe, 0xef,
 			},
 		},
+		{
+			Description: "WithSecondaryRATUsageDataReport",
+			Structured: message.NewChangeNotificationRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewRATType(gtpv2.RATTypeEUTRAN),
+				ie.NewSecondaryRATUsageDataReport(
+					0, 1, gtpv2.SecondaryRATTypeNR, 5,
+					time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, time.January, 1, 1, 0, 0, 0, time.UTC),
+					1000000, 500000,
+				),
+				ie.NewSecondaryRATUsageDataReport(
+					1, 1, gtpv2.SecondaryRATTypeNR, 6,
+					time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, time.January, 1, 1, 0, 0, 0, time.UTC),
+					0x2000, 0x1000,
+				),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x26, 0x00, 0x57, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// RATType
+				0x52, 0x00, 0x01, 0x00, 0x06,
+				// Secondary RAT Usage Data Report
+				0xc9, 0x00, 0x1b, 0x00, 0x01, 0x00, 0x05, 0xdf, 0xd5, 0x2c, 0x00, 0xdf, 0xd5, 0x3a, 0x10, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x0f, 0x42, 0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0xa1, 0x20,
+				// Secondary RAT Usage Data Report
+				0xc9, 0x00, 0x1b, 0x00, 0x03, 0x00, 0x06, 0xdf, 0xd5, 0x2c, 0x00, 0xdf, 0xd5, 0x3a, 0x10, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00,
+			},
+		},
 	}
 
 	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
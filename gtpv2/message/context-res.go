@@ -41,6 +41,7 @@ type ContextResponse struct {
 	ExtendedTraceInformation            *ie.IE
 	SubscribedAdditionalRRMPolicyIndex  *ie.IE
 	AdditionalRRMPolicyIndexInUse       *ie.IE
+	V2XContext                          *ie.IE
 	PrivateExtension                    *ie.IE
 	AdditionalIEs                       []*ie.IE
 }
@@ -67,7 +68,7 @@ func NewContextResponse(teid, seq uint32, ies ...*ie.IE) *ContextResponse {
 			ie.MMContextGSMKeyAndTriplets, ie.MMContextGSMKeyUsedCipherAndQuintuplets,
 			ie.MMContextUMTSKeyAndQuintuplets, ie.MMContextUMTSKeyQuadrupletsAndQuintuplets,
 			ie.MMContextUMTSKeyUsedCipherAndQuintuplets:
-			if c.UEMMContext != nil {
+			if c.UEMMContext == nil {
 				c.UEMMContext = i
 			} else {
 				c.AdditionalIEs = append(c.AdditionalIEs, i)
@@ -156,6 +157,8 @@ func NewContextResponse(teid, seq uint32, ies ...*ie.IE) *ContextResponse {
 			default:
 				c.AdditionalIEs = append(c.AdditionalIEs, i)
 			}
+		case ie.V2XContext:
+			c.V2XContext = i
 		case ie.PrivateExtension:
 			c.PrivateExtension = i
 		default:
@@ -346,6 +349,12 @@ func (c *ContextResponse) MarshalTo(b []byte) error {
 		}
 		offset += ie.MarshalLen()
 	}
+	if ie := c.V2XContext; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
 	if ie := c.PrivateExtension; ie != nil {
 		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
 			return err
@@ -404,7 +413,7 @@ func (c *ContextResponse) UnmarshalBinary(b []byte) error {
 			ie.MMContextGSMKeyAndTriplets, ie.MMContextGSMKeyUsedCipherAndQuintuplets,
 			ie.MMContextUMTSKeyAndQuintuplets, ie.MMContextUMTSKeyQuadrupletsAndQuintuplets,
 			ie.MMContextUMTSKeyUsedCipherAndQuintuplets:
-			if c.UEMMContext != nil {
+			if c.UEMMContext == nil {
 				c.UEMMContext = i
 			} else {
 				c.AdditionalIEs = append(c.AdditionalIEs, i)
@@ -482,6 +491,8 @@ func (c *ContextResponse) UnmarshalBinary(b []byte) error {
 			c.MOExceptionDataCounter = i
 		case ie.ExtendedTraceInformation:
 			c.ExtendedTraceInformation = i
+		case ie.V2XContext:
+			c.V2XContext = i
 		case ie.PrivateExtension:
 			c.PrivateExtension = i
 		default:
@@ -577,6 +588,9 @@ func (c *ContextResponse) MarshalLen() int {
 	if ie := c.ExtendedTraceInformation; ie != nil {
 		l += ie.MarshalLen()
 	}
+	if ie := c.V2XContext; ie != nil {
+		l += ie.MarshalLen()
+	}
 	if ie := c.PrivateExtension; ie != nil {
 		l += ie.MarshalLen()
 	}
@@ -5,6 +5,7 @@
 package message_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/wmnsk/go-gtp/gtpv2"
@@ -21,20 +22,84 @@ func TestContextResponse(t *testing.T) {
 				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
 				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
 				ie.NewIMSI("123451234567890"),
-				// ie.NewMMContext(), TODO: implement!
+				ie.NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets(
+					1, 2, 1, 1, 2, bytes.Repeat([]byte{0x11}, 32), nil, nil, []byte{0xe0, 0xe0},
+				),
+				ie.NewPDNConnection(
+					ie.NewAccessPointName("some.apn"),
+					ie.NewEPSBearerID(0x05),
+				),
 				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS10MMEGTPC, 0xffffffff, "1.1.1.1", ""),
 			),
 			Serialized: []byte{
 				// Header
-				0x48, 0x83, 0x00, 0x27, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				0x48, 0x83, 0x00, 0x6f, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
 				// Cause
 				0x02, 0x00, 0x02, 0x00, 0x10, 0x00,
 				// IMSI
 				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// MM Context
+				0x6b, 0x00, 0x2e, 0x00, 0x81, 0x00, 0x21, 0x00, 0x00, 0x01, 0x00, 0x00, 0x02,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x02, 0xe0, 0xe0, 0x00, 0x00,
+				// PDN Connection
+				0x6d, 0x00, 0x12, 0x00,
+				//   APN
+				0x47, 0x00, 0x09, 0x00, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e,
+				//   EBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
 				// F-TEID
 				0x57, 0x00, 0x09, 0x00, 0x8c, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
 			},
 		},
+		{
+			Description: "WithV2XContext",
+			Structured: message.NewContextResponse(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+				ie.NewIMSI("123451234567890"),
+				ie.NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets(
+					1, 2, 1, 1, 2, bytes.Repeat([]byte{0x11}, 32), nil, nil, []byte{0xe0, 0xe0},
+				),
+				ie.NewPDNConnection(
+					ie.NewAccessPointName("some.apn"),
+					ie.NewEPSBearerID(0x05),
+				),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS10MMEGTPC, 0xffffffff, "1.1.1.1", ""),
+				ie.NewV2XContext(
+					ie.NewServicesAuthorized(gtpv2.ServicesAuthorizedAuthorized, gtpv2.ServicesAuthorizedNotAuthorized),
+					nil, ie.NewBitRate(100000), nil, nil,
+				),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x83, 0x00, 0x81, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// Cause
+				0x02, 0x00, 0x02, 0x00, 0x10, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// MM Context
+				0x6b, 0x00, 0x2e, 0x00, 0x81, 0x00, 0x21, 0x00, 0x00, 0x01, 0x00, 0x00, 0x02,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x02, 0xe0, 0xe0, 0x00, 0x00,
+				// PDN Connection
+				0x6d, 0x00, 0x12, 0x00,
+				//   APN
+				0x47, 0x00, 0x09, 0x00, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e,
+				//   EBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+				// F-TEID
+				0x57, 0x00, 0x09, 0x00, 0x8c, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// V2X Context
+				0xd0, 0x00, 0x0e, 0x00,
+				//   LTE V2X Services Authorized
+				0xd2, 0x00, 0x02, 0x00, 0x00, 0x01,
+				//   LTE UE Sidelink AMBR
+				0xd3, 0x00, 0x04, 0x00, 0x00, 0x01, 0x86, 0xa0,
+			},
+		},
 	}
 
 	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
@@ -0,0 +1,164 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// CreateForwardingTunnelRequest is a CreateForwardingTunnelRequest Header and its IEs above.
+type CreateForwardingTunnelRequest struct {
+	*Header
+	S103PDNDataForwardingInfo []*ie.IE
+	PrivateExtension          *ie.IE
+	AdditionalIEs             []*ie.IE
+}
+
+// NewCreateForwardingTunnelRequest creates a new CreateForwardingTunnelRequest.
+func NewCreateForwardingTunnelRequest(teid, seq uint32, ies ...*ie.IE) *CreateForwardingTunnelRequest {
+	c := &CreateForwardingTunnelRequest{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeCreateForwardingTunnelRequest, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.S103PDNDataForwardingInfo:
+			c.S103PDNDataForwardingInfo = append(c.S103PDNDataForwardingInfo, i)
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	c.SetLength()
+	return c
+}
+
+// Marshal serializes CreateForwardingTunnelRequest into bytes.
+func (c *CreateForwardingTunnelRequest) Marshal() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes CreateForwardingTunnelRequest into bytes.
+func (c *CreateForwardingTunnelRequest) MarshalTo(b []byte) error {
+	if c.Header.Payload != nil {
+		c.Header.Payload = nil
+	}
+	c.Header.Payload = make([]byte, c.MarshalLen()-c.Header.MarshalLen())
+
+	offset := 0
+	for _, ie := range c.S103PDNDataForwardingInfo {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(c.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	c.Header.SetLength()
+	return c.Header.MarshalTo(b)
+}
+
+// ParseCreateForwardingTunnelRequest decodes given bytes as CreateForwardingTunnelRequest.
+func ParseCreateForwardingTunnelRequest(b []byte) (*CreateForwardingTunnelRequest, error) {
+	c := &CreateForwardingTunnelRequest{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UnmarshalBinary decodes given bytes as CreateForwardingTunnelRequest.
+func (c *CreateForwardingTunnelRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	c.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(c.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(c.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.S103PDNDataForwardingInfo:
+			c.S103PDNDataForwardingInfo = append(c.S103PDNDataForwardingInfo, i)
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (c *CreateForwardingTunnelRequest) MarshalLen() int {
+	l := c.Header.MarshalLen() - len(c.Header.Payload)
+
+	for _, ie := range c.S103PDNDataForwardingInfo {
+		l += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (c *CreateForwardingTunnelRequest) SetLength() {
+	c.Header.Length = uint16(c.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (c *CreateForwardingTunnelRequest) MessageTypeName() string {
+	return "Create Forwarding Tunnel Request"
+}
+
+// TEID returns the TEID in uint32.
+func (c *CreateForwardingTunnelRequest) TEID() uint32 {
+	return c.Header.teid()
+}
@@ -0,0 +1,178 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// CreateForwardingTunnelResponse is a CreateForwardingTunnelResponse Header and its IEs above.
+type CreateForwardingTunnelResponse struct {
+	*Header
+	Cause             *ie.IE
+	S1UDataForwarding []*ie.IE
+	PrivateExtension  *ie.IE
+	AdditionalIEs     []*ie.IE
+}
+
+// NewCreateForwardingTunnelResponse creates a new CreateForwardingTunnelResponse.
+func NewCreateForwardingTunnelResponse(teid, seq uint32, ies ...*ie.IE) *CreateForwardingTunnelResponse {
+	c := &CreateForwardingTunnelResponse{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeCreateForwardingTunnelResponse, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			c.Cause = i
+		case ie.S1UDataForwarding:
+			c.S1UDataForwarding = append(c.S1UDataForwarding, i)
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	c.SetLength()
+	return c
+}
+
+// Marshal serializes CreateForwardingTunnelResponse into bytes.
+func (c *CreateForwardingTunnelResponse) Marshal() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes CreateForwardingTunnelResponse into bytes.
+func (c *CreateForwardingTunnelResponse) MarshalTo(b []byte) error {
+	if c.Header.Payload != nil {
+		c.Header.Payload = nil
+	}
+	c.Header.Payload = make([]byte, c.MarshalLen()-c.Header.MarshalLen())
+
+	offset := 0
+	if ie := c.Cause; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	for _, ie := range c.S1UDataForwarding {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(c.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	c.Header.SetLength()
+	return c.Header.MarshalTo(b)
+}
+
+// ParseCreateForwardingTunnelResponse decodes given bytes as CreateForwardingTunnelResponse.
+func ParseCreateForwardingTunnelResponse(b []byte) (*CreateForwardingTunnelResponse, error) {
+	c := &CreateForwardingTunnelResponse{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UnmarshalBinary decodes given bytes as CreateForwardingTunnelResponse.
+func (c *CreateForwardingTunnelResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	c.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(c.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(c.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			c.Cause = i
+		case ie.S1UDataForwarding:
+			c.S1UDataForwarding = append(c.S1UDataForwarding, i)
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (c *CreateForwardingTunnelResponse) MarshalLen() int {
+	l := c.Header.MarshalLen() - len(c.Header.Payload)
+
+	if ie := c.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	for _, ie := range c.S1UDataForwarding {
+		l += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (c *CreateForwardingTunnelResponse) SetLength() {
+	c.Header.Length = uint16(c.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (c *CreateForwardingTunnelResponse) MessageTypeName() string {
+	return "Create Forwarding Tunnel Response"
+}
+
+// TEID returns the TEID in uint32.
+func (c *CreateForwardingTunnelResponse) TEID() uint32 {
+	return c.Header.teid()
+}
@@ -0,0 +1,47 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestCreateForwardingTunnelResponse(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewCreateForwardingTunnelResponse(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+				ie.NewS1UDataForwarding(5, "1.1.1.1", 0x11111111),
+				ie.NewS1UDataForwarding(6, "1.1.1.1", 0x22222222),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0xa1, 0x00, 0x2a, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// Cause
+				0x02, 0x00, 0x02, 0x00, 0x10, 0x00,
+				// S1-U Data Forwarding Info
+				0x5b, 0x00, 0x0a, 0x00, 0x05, 0x04, 0x01, 0x01, 0x01, 0x01, 0x11, 0x11, 0x11, 0x11,
+				// S1-U Data Forwarding Info
+				0x5b, 0x00, 0x0a, 0x00, 0x06, 0x04, 0x01, 0x01, 0x01, 0x01, 0x22, 0x22, 0x22, 0x22,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseCreateForwardingTunnelResponse(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
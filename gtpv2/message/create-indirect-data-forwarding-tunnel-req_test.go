@@ -0,0 +1,54 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestCreateIndirectDataForwardingTunnelRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewCreateIndirectDataForwardingTunnelRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS11MMEGTPC, 0xffffffff, "1.1.1.1", ""),
+				ie.NewBearerContextWithinCreateIndirectDataForwardingTunnelRequest(
+					ie.NewEPSBearerID(0x05),
+					ie.NewFullyQualifiedTEID(gtpv2.IFTypeeNodeBGTPUForDL, 0xffffffff, "1.1.1.4", ""),
+				),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0xa6, 0x00, 0x37, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// F-TEID
+				0x57, 0x00, 0x09, 0x00, 0x8a, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// BearerContext
+				0x5d, 0x00, 0x12, 0x00,
+				//   EBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+				//   F-TEID
+				0x57, 0x00, 0x09, 0x00, 0x93, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x04,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseCreateIndirectDataForwardingTunnelRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
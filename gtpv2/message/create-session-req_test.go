@@ -5,7 +5,9 @@
 package message_test
 
 import (
+	"net"
 	"testing"
+	"time"
 
 	"github.com/wmnsk/go-gtp/gtpv2"
 	"github.com/wmnsk/go-gtp/gtpv2/ie"
@@ -98,6 +100,36 @@ func TestCreateSessionRequest(t *testing.T) {
 				0x11, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x22,
 				0x11, 0x11, 0x11, 0x11, 0x11, 0x22, 0x22, 0x22, 0x22, 0x22,
 			},
+		}, {
+			Description: "Normal/FromTWANtoPGW",
+			Structured: message.NewCreateSessionRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewAccessPointName("some.apn.example"),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS2aTWANGTPC, 0xffffffff, "1.1.1.1", ""),
+				ie.NewRATType(gtpv2.RATTypeWLAN),
+				ie.NewTrustedWLANModeIndication(0, 1),
+				ie.NewTWANIdentifier("ssid", net.HardwareAddr{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc}, nil, nil, nil, 0, nil, nil),
+				ie.NewTWANIdentifierTimestamp(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x20, 0x00, 0x58, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// RATType
+				0x52, 0x00, 0x01, 0x00, 0x03,
+				// F-TEID S2a
+				0x57, 0x00, 0x09, 0x00, 0xa3, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// APN
+				0x47, 0x00, 0x11, 0x00, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+				// TrustedWLANModeIndication
+				0xae, 0x00, 0x01, 0x00, 0x01,
+				// TWANIdentifier
+				0xa9, 0x00, 0x0c, 0x00, 0x01, 0x04, 0x73, 0x73, 0x69, 0x64, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc,
+				// WLANLocationTimestamp
+				0xb3, 0x00, 0x04, 0x00, 0xdf, 0xd5, 0x2c, 0x00,
+			},
 		},
 	}
 
@@ -0,0 +1,262 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// CSPagingIndication is a CSPagingIndication Header and its IEs above.
+type CSPagingIndication struct {
+	*Header
+	IMSI                   *ie.IE
+	VLRName                *ie.IE
+	TMSI                   *ie.IE
+	LocationAreaIdentifier *ie.IE
+	GlobalCNID             *ie.IE
+	ChannelNeeded          *ie.IE
+	EMLPPPriority          *ie.IE
+	ServiceIndicator       *ie.IE
+	PrivateExtension       *ie.IE
+	AdditionalIEs          []*ie.IE
+}
+
+// NewCSPagingIndication creates a new CSPagingIndication.
+func NewCSPagingIndication(teid, seq uint32, ies ...*ie.IE) *CSPagingIndication {
+	c := &CSPagingIndication{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeCSPagingIndication, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			c.IMSI = i
+		case ie.FullyQualifiedDomainName:
+			c.VLRName = i
+		case ie.TMSI:
+			c.TMSI = i
+		case ie.UserLocationInformation:
+			c.LocationAreaIdentifier = i
+		case ie.GlobalCNID:
+			c.GlobalCNID = i
+		case ie.ChannelNeeded:
+			c.ChannelNeeded = i
+		case ie.EMLPPPriority:
+			c.EMLPPPriority = i
+		case ie.ServiceIndicator:
+			c.ServiceIndicator = i
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	c.SetLength()
+	return c
+}
+
+// Marshal serializes CSPagingIndication into bytes.
+func (c *CSPagingIndication) Marshal() ([]byte, error) {
+	b := make([]byte, c.MarshalLen())
+	if err := c.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes CSPagingIndication into bytes.
+func (c *CSPagingIndication) MarshalTo(b []byte) error {
+	if c.Header.Payload != nil {
+		c.Header.Payload = nil
+	}
+	c.Header.Payload = make([]byte, c.MarshalLen()-c.Header.MarshalLen())
+
+	offset := 0
+	if ie := c.IMSI; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.VLRName; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.TMSI; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.LocationAreaIdentifier; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.GlobalCNID; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.ChannelNeeded; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.EMLPPPriority; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.ServiceIndicator; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(c.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(c.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	c.Header.SetLength()
+	return c.Header.MarshalTo(b)
+}
+
+// ParseCSPagingIndication decodes given bytes as CSPagingIndication.
+func ParseCSPagingIndication(b []byte) (*CSPagingIndication, error) {
+	c := &CSPagingIndication{}
+	if err := c.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UnmarshalBinary decodes given bytes as CSPagingIndication.
+func (c *CSPagingIndication) UnmarshalBinary(b []byte) error {
+	var err error
+	c.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(c.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(c.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			c.IMSI = i
+		case ie.FullyQualifiedDomainName:
+			c.VLRName = i
+		case ie.TMSI:
+			c.TMSI = i
+		case ie.UserLocationInformation:
+			c.LocationAreaIdentifier = i
+		case ie.GlobalCNID:
+			c.GlobalCNID = i
+		case ie.ChannelNeeded:
+			c.ChannelNeeded = i
+		case ie.EMLPPPriority:
+			c.EMLPPPriority = i
+		case ie.ServiceIndicator:
+			c.ServiceIndicator = i
+		case ie.PrivateExtension:
+			c.PrivateExtension = i
+		default:
+			c.AdditionalIEs = append(c.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (c *CSPagingIndication) MarshalLen() int {
+	l := c.Header.MarshalLen() - len(c.Header.Payload)
+
+	if ie := c.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.VLRName; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.TMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.LocationAreaIdentifier; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.GlobalCNID; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.ChannelNeeded; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.EMLPPPriority; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.ServiceIndicator; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := c.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range c.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (c *CSPagingIndication) SetLength() {
+	c.Header.Length = uint16(c.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (c *CSPagingIndication) MessageTypeName() string {
+	return "CS Paging Indication"
+}
+
+// TEID returns the TEID in uint32.
+func (c *CSPagingIndication) TEID() uint32 {
+	return c.Header.teid()
+}
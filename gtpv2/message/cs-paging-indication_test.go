@@ -0,0 +1,64 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestCSPagingIndication(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewCSPagingIndication(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewFullyQualifiedDomainName("vlr.example"),
+				ie.NewTMSI(0xdeadbeef),
+				ie.NewUserLocationInformationStruct(
+					nil, nil, nil, nil, nil, ie.NewLAI("123", "45", 0x1111), nil, nil,
+				),
+				ie.NewGlobalCNID("123", "45", 0xfff),
+				ie.NewChannelNeeded(0x05),
+				ie.NewEMLPPPriority(0x03),
+				ie.NewServiceIndicator(gtpv2.ServiceIndCSCall),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x97, 0x00, 0x4e, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// VLR Name
+				0x88, 0x00, 0x0c, 0x00, 0x03, 0x76, 0x6c, 0x72, 0x07, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+				// TMSI
+				0x58, 0x00, 0x04, 0x00, 0xde, 0xad, 0xbe, 0xef,
+				// Location Area Identifier
+				0x56, 0x00, 0x06, 0x00, 0x20, 0x21, 0xf3, 0x54, 0x11, 0x11,
+				// Global CN-Id
+				0x59, 0x00, 0x05, 0x00, 0x21, 0xf3, 0x54, 0x0f, 0xff,
+				// Channel Needed
+				0x85, 0x00, 0x01, 0x00, 0x05,
+				// eMLPP Priority
+				0x86, 0x00, 0x01, 0x00, 0x03,
+				// Service Indicator
+				0x95, 0x00, 0x01, 0x00, 0x01,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseCSPagingIndication(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
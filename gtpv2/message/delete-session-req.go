@@ -32,9 +32,11 @@ type DeleteSessionRequest struct {
 	UEUDPPort                         *ie.IE
 	EPCO                              *ie.IE
 	UETCPPort                         *ie.IE
-	SecondaryRATUsageDataReport       *ie.IE
-	PrivateExtension                  *ie.IE
-	AdditionalIEs                     []*ie.IE
+	// SecondaryRATUsageDataReport is the first one in the message. The others
+	// are stored in AdditionalIEs.
+	SecondaryRATUsageDataReport *ie.IE
+	PrivateExtension            *ie.IE
+	AdditionalIEs               []*ie.IE
 }
 
 // NewDeleteSessionRequest creates a new DeleteSessionRequest.
@@ -114,7 +116,11 @@ func NewDeleteSessionRequest(teid, seq uint32, ies ...*ie.IE) *DeleteSessionRequ
 		case ie.ExtendedProtocolConfigurationOptions:
 			d.EPCO = i
 		case ie.SecondaryRATUsageDataReport:
-			d.SecondaryRATUsageDataReport = i
+			if d.SecondaryRATUsageDataReport == nil {
+				d.SecondaryRATUsageDataReport = i
+			} else {
+				d.AdditionalIEs = append(d.AdditionalIEs, i)
+			}
 		case ie.PrivateExtension:
 			d.PrivateExtension = i
 		default:
@@ -389,7 +395,11 @@ func (d *DeleteSessionRequest) UnmarshalBinary(b []byte) error {
 		case ie.ExtendedProtocolConfigurationOptions:
 			d.EPCO = i
 		case ie.SecondaryRATUsageDataReport:
-			d.SecondaryRATUsageDataReport = i
+			if d.SecondaryRATUsageDataReport == nil {
+				d.SecondaryRATUsageDataReport = i
+			} else {
+				d.AdditionalIEs = append(d.AdditionalIEs, i)
+			}
 		case ie.PrivateExtension:
 			d.PrivateExtension = i
 		default:
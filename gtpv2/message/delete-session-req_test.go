@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/wmnsk/go-gtp/gtpv2"
 	"github.com/wmnsk/go-gtp/gtpv2/ie"
 	"github.com/wmnsk/go-gtp/gtpv2/message"
 	"github.com/wmnsk/go-gtp/gtpv2/testutils"
@@ -42,6 +43,35 @@ func TestDeleteSessionRequest(t *testing.T) {
 				0xaa, 0x00, 0x04, 0x00, 0xdf, 0xd5, 0x2c, 0x00,
 			},
 		},
+		{
+			Description: "WithSecondaryRATUsageDataReport",
+			Structured: message.NewDeleteSessionRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewEPSBearerID(5),
+				ie.NewSecondaryRATUsageDataReport(
+					0, 1, gtpv2.SecondaryRATTypeNR, 5,
+					time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, time.January, 1, 1, 0, 0, 0, time.UTC),
+					1000000, 500000,
+				),
+				ie.NewSecondaryRATUsageDataReport(
+					1, 1, gtpv2.SecondaryRATTypeNR, 6,
+					time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, time.January, 1, 1, 0, 0, 0, time.UTC),
+					0x2000, 0x1000,
+				),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x24, 0x00, 0x4b, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// EBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+				// Secondary RAT Usage Data Report
+				0xc9, 0x00, 0x1b, 0x00, 0x01, 0x00, 0x05, 0xdf, 0xd5, 0x2c, 0x00, 0xdf, 0xd5, 0x3a, 0x10, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x0f, 0x42, 0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0xa1, 0x20,
+				// Secondary RAT Usage Data Report
+				0xc9, 0x00, 0x1b, 0x00, 0x03, 0x00, 0x06, 0xdf, 0xd5, 0x2c, 0x00, 0xdf, 0xd5, 0x3a, 0x10, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00,
+			},
+		},
 	}
 
 	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
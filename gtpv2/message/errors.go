@@ -10,4 +10,5 @@ import "errors"
 var (
 	ErrInvalidLength   = errors.New("length value is invalid")
 	ErrTooShortToParse = errors.New("too short to decode as GTP")
+	ErrNestedPiggyback = errors.New("piggybacked message must not have another piggybacked message")
 )
@@ -0,0 +1,192 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// ForwardRelocationCompleteAcknowledge is a ForwardRelocationCompleteAcknowledge Header and its IEs above.
+type ForwardRelocationCompleteAcknowledge struct {
+	*Header
+	Cause                       *ie.IE
+	Recovery                    *ie.IE
+	SecondaryRATUsageDataReport []*ie.IE
+	PrivateExtension            *ie.IE
+	AdditionalIEs               []*ie.IE
+}
+
+// NewForwardRelocationCompleteAcknowledge creates a new ForwardRelocationCompleteAcknowledge.
+func NewForwardRelocationCompleteAcknowledge(teid, seq uint32, ies ...*ie.IE) *ForwardRelocationCompleteAcknowledge {
+	f := &ForwardRelocationCompleteAcknowledge{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeForwardRelocationCompleteAcknowledge, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			f.Cause = i
+		case ie.Recovery:
+			f.Recovery = i
+		case ie.SecondaryRATUsageDataReport:
+			f.SecondaryRATUsageDataReport = append(f.SecondaryRATUsageDataReport, i)
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	f.SetLength()
+	return f
+}
+
+// Marshal serializes ForwardRelocationCompleteAcknowledge into bytes.
+func (f *ForwardRelocationCompleteAcknowledge) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes ForwardRelocationCompleteAcknowledge into bytes.
+func (f *ForwardRelocationCompleteAcknowledge) MarshalTo(b []byte) error {
+	if f.Header.Payload != nil {
+		f.Header.Payload = nil
+	}
+	f.Header.Payload = make([]byte, f.MarshalLen()-f.Header.MarshalLen())
+
+	offset := 0
+	if ie := f.Cause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.Recovery; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	for _, ie := range f.SecondaryRATUsageDataReport {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	f.Header.SetLength()
+	return f.Header.MarshalTo(b)
+}
+
+// ParseForwardRelocationCompleteAcknowledge decodes given bytes as ForwardRelocationCompleteAcknowledge.
+func ParseForwardRelocationCompleteAcknowledge(b []byte) (*ForwardRelocationCompleteAcknowledge, error) {
+	f := &ForwardRelocationCompleteAcknowledge{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes as ForwardRelocationCompleteAcknowledge.
+func (f *ForwardRelocationCompleteAcknowledge) UnmarshalBinary(b []byte) error {
+	var err error
+	f.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(f.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(f.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			f.Cause = i
+		case ie.Recovery:
+			f.Recovery = i
+		case ie.SecondaryRATUsageDataReport:
+			f.SecondaryRATUsageDataReport = append(f.SecondaryRATUsageDataReport, i)
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (f *ForwardRelocationCompleteAcknowledge) MarshalLen() int {
+	l := f.Header.MarshalLen() - len(f.Header.Payload)
+
+	if ie := f.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.Recovery; ie != nil {
+		l += ie.MarshalLen()
+	}
+	for _, ie := range f.SecondaryRATUsageDataReport {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (f *ForwardRelocationCompleteAcknowledge) SetLength() {
+	f.Header.Length = uint16(f.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (f *ForwardRelocationCompleteAcknowledge) MessageTypeName() string {
+	return "Forward Relocation Complete Acknowledge"
+}
+
+// TEID returns the TEID in uint32.
+func (f *ForwardRelocationCompleteAcknowledge) TEID() uint32 {
+	return f.Header.teid()
+}
@@ -0,0 +1,78 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestForwardRelocationRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewForwardRelocationRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS10MMEGTPC, 0xffffffff, "1.1.1.1", ""),
+				ie.NewPDNConnection(
+					ie.NewAccessPointName("some.apn"),
+					ie.NewEPSBearerID(0x05),
+				),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS11S4SGWGTPC, 0xffffffff, "1.1.1.2", "").WithInstance(1),
+				ie.NewMMContextEPSSecurityContextQuadrupletsAndQuintuplets(
+					1, 2, 1, 1, 2, bytes.Repeat([]byte{0x11}, 32), nil, nil, []byte{0xe0, 0xe0},
+				),
+				ie.NewFContainer(gtpv2.FContainerTypeEUTRANTransparentContainer, []byte{0xde, 0xad, 0xbe, 0xef}),
+				ie.NewTargetIdentificationMacroENodeBID("123", "45", 0x12345, 0x0001),
+				ie.NewFCause(gtpv2.CauseTypeRadioNetworkLayer, []byte{0x02}),
+				ie.NewPLMNID("123", "45"),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x85, 0x00, 0x99, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// Sender F-TEID for Control Plane
+				0x57, 0x00, 0x09, 0x00, 0x8c, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// PDN Connection
+				0x6d, 0x00, 0x12, 0x00,
+				//   APN
+				0x47, 0x00, 0x09, 0x00, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x03, 0x61, 0x70, 0x6e,
+				//   EBI
+				0x49, 0x00, 0x01, 0x00, 0x05,
+				// SGW S11/S4 F-TEID
+				0x57, 0x00, 0x09, 0x01, 0x8b, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x02,
+				// MM Context
+				0x6b, 0x00, 0x2e, 0x00, 0x81, 0x00, 0x21, 0x00, 0x00, 0x01, 0x00, 0x00, 0x02,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+				0x02, 0xe0, 0xe0, 0x00, 0x00,
+				// E-UTRAN Transparent Container
+				0x76, 0x00, 0x05, 0x00, 0x03, 0xde, 0xad, 0xbe, 0xef,
+				// Target Identification
+				0x79, 0x00, 0x09, 0x00, 0x01, 0x21, 0xf3, 0x54, 0x01, 0x23, 0x45, 0x00, 0x01,
+				// S1-AP Cause
+				0x77, 0x00, 0x02, 0x00, 0x00, 0x02,
+				// Selected PLMN ID
+				0x78, 0x00, 0x03, 0x00, 0x21, 0xf3, 0x54,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseForwardRelocationRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
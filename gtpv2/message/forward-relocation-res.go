@@ -0,0 +1,504 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// ForwardRelocationResponse is a ForwardRelocationResponse Header and its IEs above.
+type ForwardRelocationResponse struct {
+	*Header
+	Cause                                   *ie.IE
+	SenderFTEIDC                            *ie.IE
+	IndicationFlags                         *ie.IE
+	ListOfSetupBearers                      []*ie.IE
+	ListOfSetupRABs                         []*ie.IE
+	ListOfSetupPFCs                         []*ie.IE
+	ListOfSetupBearersForSCEFPDNConnections []*ie.IE
+	S1APCause                               *ie.IE
+	RANAPCause                              *ie.IE
+	BSSGPCause                              *ie.IE
+	EUTRANTransparentContainer              *ie.IE
+	UTRANTransparentContainer               *ie.IE
+	BSSContainer                            *ie.IE
+	MMENodeName                             *ie.IE
+	SGSNNodeName                            *ie.IE
+	SGSNNumber                              *ie.IE
+	MMENumberForMTSMS                       *ie.IE
+	SGSNIdentifier                          *ie.IE
+	MMEIdentifier                           *ie.IE
+	SGSNIdentifierForMTSMS                  *ie.IE
+	MMEIdentifierForMTSMS                   *ie.IE
+	PrivateExtension                        *ie.IE
+	AdditionalIEs                           []*ie.IE
+}
+
+// NewForwardRelocationResponse creates a new ForwardRelocationResponse.
+func NewForwardRelocationResponse(teid, seq uint32, ies ...*ie.IE) *ForwardRelocationResponse {
+	f := &ForwardRelocationResponse{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeForwardRelocationResponse, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			f.Cause = i
+		case ie.FullyQualifiedTEID:
+			f.SenderFTEIDC = i
+		case ie.Indication:
+			f.IndicationFlags = i
+		case ie.BearerContext:
+			switch i.Instance() {
+			case 0:
+				f.ListOfSetupBearers = append(f.ListOfSetupBearers, i)
+			case 1:
+				f.ListOfSetupRABs = append(f.ListOfSetupRABs, i)
+			case 2:
+				f.ListOfSetupPFCs = append(f.ListOfSetupPFCs, i)
+			case 3:
+				f.ListOfSetupBearersForSCEFPDNConnections = append(f.ListOfSetupBearersForSCEFPDNConnections, i)
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FCause:
+			switch i.Instance() {
+			case 0:
+				f.S1APCause = i
+			case 1:
+				f.RANAPCause = i
+			case 2:
+				f.BSSGPCause = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FContainer:
+			switch i.Instance() {
+			case 0:
+				f.EUTRANTransparentContainer = i
+			case 1:
+				f.UTRANTransparentContainer = i
+			case 2:
+				f.BSSContainer = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FullyQualifiedDomainName:
+			switch i.Instance() {
+			case 0:
+				f.MMENodeName = i
+			case 1:
+				f.SGSNNodeName = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.NodeNumber:
+			switch i.Instance() {
+			case 0:
+				f.SGSNNumber = i
+			case 1:
+				f.MMENumberForMTSMS = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.NodeIdentifier:
+			switch i.Instance() {
+			case 0:
+				f.SGSNIdentifier = i
+			case 1:
+				f.MMEIdentifier = i
+			case 2:
+				f.SGSNIdentifierForMTSMS = i
+			case 3:
+				f.MMEIdentifierForMTSMS = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	f.SetLength()
+	return f
+}
+
+// Marshal serializes ForwardRelocationResponse into bytes.
+func (f *ForwardRelocationResponse) Marshal() ([]byte, error) {
+	b := make([]byte, f.MarshalLen())
+	if err := f.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes ForwardRelocationResponse into bytes.
+func (f *ForwardRelocationResponse) MarshalTo(b []byte) error {
+	if f.Header.Payload != nil {
+		f.Header.Payload = nil
+	}
+	f.Header.Payload = make([]byte, f.MarshalLen()-f.Header.MarshalLen())
+
+	offset := 0
+	if ie := f.Cause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SenderFTEIDC; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.IndicationFlags; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	for _, ie := range f.ListOfSetupBearers {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	for _, ie := range f.ListOfSetupRABs {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	for _, ie := range f.ListOfSetupPFCs {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	for _, ie := range f.ListOfSetupBearersForSCEFPDNConnections {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.S1APCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.RANAPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.BSSGPCause; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.EUTRANTransparentContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.UTRANTransparentContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.BSSContainer; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MMENodeName; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGSNNodeName; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGSNNumber; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MMENumberForMTSMS; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGSNIdentifier; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MMEIdentifier; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.SGSNIdentifierForMTSMS; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.MMEIdentifierForMTSMS; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(f.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(f.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	f.Header.SetLength()
+	return f.Header.MarshalTo(b)
+}
+
+// ParseForwardRelocationResponse decodes given bytes as ForwardRelocationResponse.
+func ParseForwardRelocationResponse(b []byte) (*ForwardRelocationResponse, error) {
+	f := &ForwardRelocationResponse{}
+	if err := f.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// UnmarshalBinary decodes given bytes as ForwardRelocationResponse.
+func (f *ForwardRelocationResponse) UnmarshalBinary(b []byte) error {
+	var err error
+	f.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(f.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(f.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			f.Cause = i
+		case ie.FullyQualifiedTEID:
+			f.SenderFTEIDC = i
+		case ie.Indication:
+			f.IndicationFlags = i
+		case ie.BearerContext:
+			switch i.Instance() {
+			case 0:
+				f.ListOfSetupBearers = append(f.ListOfSetupBearers, i)
+			case 1:
+				f.ListOfSetupRABs = append(f.ListOfSetupRABs, i)
+			case 2:
+				f.ListOfSetupPFCs = append(f.ListOfSetupPFCs, i)
+			case 3:
+				f.ListOfSetupBearersForSCEFPDNConnections = append(f.ListOfSetupBearersForSCEFPDNConnections, i)
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FCause:
+			switch i.Instance() {
+			case 0:
+				f.S1APCause = i
+			case 1:
+				f.RANAPCause = i
+			case 2:
+				f.BSSGPCause = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FContainer:
+			switch i.Instance() {
+			case 0:
+				f.EUTRANTransparentContainer = i
+			case 1:
+				f.UTRANTransparentContainer = i
+			case 2:
+				f.BSSContainer = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.FullyQualifiedDomainName:
+			switch i.Instance() {
+			case 0:
+				f.MMENodeName = i
+			case 1:
+				f.SGSNNodeName = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.NodeNumber:
+			switch i.Instance() {
+			case 0:
+				f.SGSNNumber = i
+			case 1:
+				f.MMENumberForMTSMS = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.NodeIdentifier:
+			switch i.Instance() {
+			case 0:
+				f.SGSNIdentifier = i
+			case 1:
+				f.MMEIdentifier = i
+			case 2:
+				f.SGSNIdentifierForMTSMS = i
+			case 3:
+				f.MMEIdentifierForMTSMS = i
+			default:
+				f.AdditionalIEs = append(f.AdditionalIEs, i)
+			}
+		case ie.PrivateExtension:
+			f.PrivateExtension = i
+		default:
+			f.AdditionalIEs = append(f.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (f *ForwardRelocationResponse) MarshalLen() int {
+	l := f.Header.MarshalLen() - len(f.Header.Payload)
+
+	if ie := f.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SenderFTEIDC; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.IndicationFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	for _, ie := range f.ListOfSetupBearers {
+		l += ie.MarshalLen()
+	}
+	for _, ie := range f.ListOfSetupRABs {
+		l += ie.MarshalLen()
+	}
+	for _, ie := range f.ListOfSetupPFCs {
+		l += ie.MarshalLen()
+	}
+	for _, ie := range f.ListOfSetupBearersForSCEFPDNConnections {
+		l += ie.MarshalLen()
+	}
+	if ie := f.S1APCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.RANAPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.BSSGPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.EUTRANTransparentContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.UTRANTransparentContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.BSSContainer; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MMENodeName; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGSNNodeName; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGSNNumber; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MMENumberForMTSMS; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGSNIdentifier; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MMEIdentifier; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.SGSNIdentifierForMTSMS; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.MMEIdentifierForMTSMS; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := f.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range f.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (f *ForwardRelocationResponse) SetLength() {
+	f.Header.Length = uint16(f.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (f *ForwardRelocationResponse) MessageTypeName() string {
+	return "Forward Relocation Response"
+}
+
+// TEID returns the TEID in uint32.
+func (f *ForwardRelocationResponse) TEID() uint32 {
+	return f.Header.teid()
+}
@@ -28,6 +28,9 @@ type Header struct {
 	SequenceNumber uint32
 	Spare          uint8
 	Payload        []byte
+
+	// piggybacked is the message following the message with this Header.
+	piggybacked Message
 }
 
 // NewHeader creates a new Header
@@ -177,6 +180,28 @@ func (h *Header) SetPiggybacking(val uint8) {
 	h.Flags = (h.Flags & 0xef) | (val & 0x01 << 4)
 }
 
+// Piggybacked returns the message piggybacked on the message with this Header,
+// or nil if there is none.
+func (h *Header) Piggybacked() Message {
+	return h.piggybacked
+}
+
+// SetPiggybacked sets the message to be piggybacked on the message with this Header,
+// and sets the Piggybacking flag accordingly. Giving nil removes the piggybacked message.
+//
+// The piggybacked message is appended to the message by Marshal (not by the MarshalTo
+// method of each message), and is not counted in the Length field.
+//
+// TS 29.274 5.5.1 Piggybacking of Initial Messages
+func (h *Header) SetPiggybacked(m Message) {
+	h.piggybacked = m
+	if m == nil {
+		h.SetPiggybacking(0)
+		return
+	}
+	h.SetPiggybacking(1)
+}
+
 // HasTEID determines whether a GTPv2 has TEID inside by checking the flag.
 func (h *Header) HasTEID() bool {
 	return (int(h.Flags)>>3)&0x01 == 1
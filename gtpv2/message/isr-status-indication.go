@@ -0,0 +1,164 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// ISRStatusIndication is a ISRStatusIndication Header and its IEs above.
+type ISRStatusIndication struct {
+	*Header
+	ActionIndication *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewISRStatusIndication creates a new ISRStatusIndication.
+func NewISRStatusIndication(teid, seq uint32, ies ...*ie.IE) *ISRStatusIndication {
+	s := &ISRStatusIndication{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeISRStatusIndication, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.ActionIndication:
+			s.ActionIndication = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	s.SetLength()
+	return s
+}
+
+// Marshal serializes ISRStatusIndication into bytes.
+func (s *ISRStatusIndication) Marshal() ([]byte, error) {
+	b := make([]byte, s.MarshalLen())
+	if err := s.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes ISRStatusIndication into bytes.
+func (s *ISRStatusIndication) MarshalTo(b []byte) error {
+	if s.Header.Payload != nil {
+		s.Header.Payload = nil
+	}
+	s.Header.Payload = make([]byte, s.MarshalLen()-s.Header.MarshalLen())
+
+	offset := 0
+	if ie := s.ActionIndication; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(s.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	s.Header.SetLength()
+	return s.Header.MarshalTo(b)
+}
+
+// ParseISRStatusIndication decodes given bytes as ISRStatusIndication.
+func ParseISRStatusIndication(b []byte) (*ISRStatusIndication, error) {
+	s := &ISRStatusIndication{}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalBinary decodes given bytes as ISRStatusIndication.
+func (s *ISRStatusIndication) UnmarshalBinary(b []byte) error {
+	var err error
+	s.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(s.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(s.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.ActionIndication:
+			s.ActionIndication = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (s *ISRStatusIndication) MarshalLen() int {
+	l := s.Header.MarshalLen() - len(s.Header.Payload)
+
+	if ie := s.ActionIndication; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (s *ISRStatusIndication) SetLength() {
+	s.Header.Length = uint16(s.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (s *ISRStatusIndication) MessageTypeName() string {
+	return "ISR Status Indication"
+}
+
+// TEID returns the TEID in uint32.
+func (s *ISRStatusIndication) TEID() uint32 {
+	return s.Header.teid()
+}
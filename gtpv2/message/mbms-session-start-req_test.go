@@ -0,0 +1,55 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestMBMSSessionStartRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewMBMSSessionStartRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeSmMBMSGWGTPC, 0xffffffff, "1.1.1.1", ""),
+				ie.NewTMGI(0x123456, "123", "45"),
+				ie.NewMBMSSessionDuration(25*time.Hour),
+				ie.NewMBMSServiceArea(1),
+				ie.NewMBMSIPMulticastDistribution(0x11111111, "239.0.0.1", "1.1.1.1", gtpv2.MBMSHCIndicatorUncompressedHeader),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0xe7, 0x00, 0x40, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// F-TEID
+				0x57, 0x00, 0x09, 0x00, 0x98, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// TMGI
+				0x9e, 0x00, 0x06, 0x00, 0x12, 0x34, 0x56, 0x21, 0xf3, 0x54,
+				// MBMS Session Duration
+				0x8a, 0x00, 0x03, 0x00, 0x07, 0x08, 0x01,
+				// MBMS Service Area
+				0x8b, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01,
+				// MBMS IP Multicast Distribution
+				0x8e, 0x00, 0x0f, 0x00, 0x11, 0x11, 0x11, 0x11,
+				0x04, 0xef, 0x00, 0x00, 0x01, 0x04, 0x01, 0x01, 0x01, 0x01, 0x00,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseMBMSSessionStartRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestMBMSSessionUpdateRequest(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewMBMSSessionUpdateRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewMBMSServiceArea(1),
+				ie.NewTMGI(0x123456, "123", "45"),
+				ie.NewMBMSSessionDuration(25*time.Hour),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0xe9, 0x00, 0x20, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// MBMS Service Area
+				0x8b, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01,
+				// TMGI
+				0x9e, 0x00, 0x06, 0x00, 0x12, 0x34, 0x56, 0x21, 0xf3, 0x54,
+				// MBMS Session Duration
+				0x8a, 0x00, 0x03, 0x00, 0x07, 0x08, 0x01,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseMBMSSessionUpdateRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -8,6 +8,7 @@ Package message provides encoding/decoding feature of GTPv2 protocol.
 package message
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
 	"reflect"
@@ -288,6 +289,8 @@ type Message interface {
 	SetTEID(uint32)
 	Sequence() uint32
 	SetSequenceNumber(uint32)
+	Piggybacked() Message
+	SetPiggybacked(Message)
 
 	// deprecated
 	SerializeTo([]byte) error
@@ -296,11 +299,27 @@ type Message interface {
 
 // Marshal returns the byte sequence generated from a Message instance.
 // Better to use MarshalXxx instead if you know the name of message to be serialized.
+//
+// If the Message has a piggybacked message, it is appended after the Message.
 func Marshal(m Message) ([]byte, error) {
-	b := make([]byte, m.MarshalLen())
+	l := m.MarshalLen()
+	p := m.Piggybacked()
+	if p != nil {
+		if p.Piggybacked() != nil {
+			return nil, ErrNestedPiggyback
+		}
+		l += p.MarshalLen()
+	}
+
+	b := make([]byte, l)
 	if err := m.MarshalTo(b); err != nil {
 		return nil, err
 	}
+	if p != nil {
+		if err := p.MarshalTo(b[m.MarshalLen():]); err != nil {
+			return nil, err
+		}
+	}
 
 	return b, nil
 }
@@ -320,6 +339,31 @@ func Parse(b []byte) (Message, error) {
 		m = &EchoResponse{}
 	case MsgTypeVersionNotSupportedIndication:
 		m = &VersionNotSupportedIndication{}
+	case MsgTypeSRVCCPsToCsRequest:
+		m = &SRVCCPsToCsRequest{}
+	case MsgTypeSRVCCPsToCsResponse:
+		m = &SRVCCPsToCsResponse{}
+	case MsgTypeSRVCCPsToCsCompleteNotification:
+		m = &SRVCCPsToCsCompleteNotification{}
+	case MsgTypeSRVCCPsToCsCompleteAcknowledge:
+		m = &SRVCCPsToCsCompleteAcknowledge{}
+	case MsgTypeSRVCCPsToCsCancelNotification:
+		m = &SRVCCPsToCsCancelNotification{}
+	case MsgTypeSRVCCPsToCsCancelAcknowledge:
+		m = &SRVCCPsToCsCancelAcknowledge{}
+	case MsgTypeSRVCCCsToPsRequest:
+		m = &SRVCCCsToPsRequest{}
+	// the rest of SRVCC CS to PS messages are 240-244, kept here with the request.
+	case MsgTypeSRVCCCsToPsResponse:
+		m = &SRVCCCsToPsResponse{}
+	case MsgTypeSRVCCCsToPsCompleteNotification:
+		m = &SRVCCCsToPsCompleteNotification{}
+	case MsgTypeSRVCCCsToPsCompleteAcknowledge:
+		m = &SRVCCCsToPsCompleteAcknowledge{}
+	case MsgTypeSRVCCCsToPsCancelNotification:
+		m = &SRVCCCsToPsCancelNotification{}
+	case MsgTypeSRVCCCsToPsCancelAcknowledge:
+		m = &SRVCCCsToPsCancelAcknowledge{}
 	case MsgTypeCreateSessionRequest:
 		m = &CreateSessionRequest{}
 	case MsgTypeCreateSessionResponse:
@@ -336,6 +380,14 @@ func Parse(b []byte) (Message, error) {
 		m = &DeleteBearerCommand{}
 	case MsgTypeDeleteBearerFailureIndication:
 		m = &DeleteBearerFailureIndication{}
+	case MsgTypeBearerResourceCommand:
+		m = &BearerResourceCommand{}
+	case MsgTypeBearerResourceFailureIndication:
+		m = &BearerResourceFailureIndication{}
+	case MsgTypeTraceSessionActivation:
+		m = &TraceSessionActivation{}
+	case MsgTypeTraceSessionDeactivation:
+		m = &TraceSessionDeactivation{}
 	case MsgTypeDeleteBearerRequest:
 		m = &DeleteBearerRequest{}
 	case MsgTypeCreateBearerRequest:
@@ -358,6 +410,26 @@ func Parse(b []byte) (Message, error) {
 		m = &ContextResponse{}
 	case MsgTypeContextAcknowledge:
 		m = &ContextAcknowledge{}
+	case MsgTypeForwardRelocationRequest:
+		m = &ForwardRelocationRequest{}
+	case MsgTypeForwardRelocationResponse:
+		m = &ForwardRelocationResponse{}
+	case MsgTypeForwardRelocationCompleteNotification:
+		m = &ForwardRelocationCompleteNotification{}
+	case MsgTypeForwardRelocationCompleteAcknowledge:
+		m = &ForwardRelocationCompleteAcknowledge{}
+	case MsgTypeRelocationCancelRequest:
+		m = &RelocationCancelRequest{}
+	case MsgTypeRelocationCancelResponse:
+		m = &RelocationCancelResponse{}
+	case MsgTypeCreateIndirectDataForwardingTunnelRequest:
+		m = &CreateIndirectDataForwardingTunnelRequest{}
+	case MsgTypeCreateIndirectDataForwardingTunnelResponse:
+		m = &CreateIndirectDataForwardingTunnelResponse{}
+	case MsgTypeDeleteIndirectDataForwardingTunnelRequest:
+		m = &DeleteIndirectDataForwardingTunnelRequest{}
+	case MsgTypeDeleteIndirectDataForwardingTunnelResponse:
+		m = &DeleteIndirectDataForwardingTunnelResponse{}
 	case MsgTypeReleaseAccessBearersRequest:
 		m = &ReleaseAccessBearersRequest{}
 	case MsgTypeReleaseAccessBearersResponse:
@@ -372,6 +444,10 @@ func Parse(b []byte) (Message, error) {
 		m = &DeletePDNConnectionSetRequest{}
 	case MsgTypeDeletePDNConnectionSetResponse:
 		m = &DeletePDNConnectionSetResponse{}
+	case MsgTypePGWDownlinkTriggeringNotification:
+		m = &PGWDownlinkTriggeringNotification{}
+	case MsgTypePGWDownlinkTriggeringAcknowledge:
+		m = &PGWDownlinkTriggeringAcknowledge{}
 	case MsgTypeUpdatePDNConnectionSetRequest:
 		m = &UpdatePDNConnectionSetRequest{}
 	case MsgTypeUpdatePDNConnectionSetResponse:
@@ -384,6 +460,26 @@ func Parse(b []byte) (Message, error) {
 		m = &DetachNotification{}
 	case MsgTypeDetachAcknowledge:
 		m = &DetachAcknowledge{}
+	case MsgTypeCSPagingIndication:
+		m = &CSPagingIndication{}
+	case MsgTypeAlertMMENotification:
+		m = &AlertMMENotification{}
+	case MsgTypeAlertMMEAcknowledge:
+		m = &AlertMMEAcknowledge{}
+	case MsgTypeUEActivityNotification:
+		m = &UEActivityNotification{}
+	case MsgTypeUEActivityAcknowledge:
+		m = &UEActivityAcknowledge{}
+	case MsgTypeISRStatusIndication:
+		m = &ISRStatusIndication{}
+	case MsgTypeUERegistrationQueryRequest:
+		m = &UERegistrationQueryRequest{}
+	case MsgTypeUERegistrationQueryResponse:
+		m = &UERegistrationQueryResponse{}
+	case MsgTypeCreateForwardingTunnelRequest:
+		m = &CreateForwardingTunnelRequest{}
+	case MsgTypeCreateForwardingTunnelResponse:
+		m = &CreateForwardingTunnelResponse{}
 	case MsgTypeResumeAcknowledge:
 		m = &ResumeAcknowledge{}
 	case MsgTypeResumeNotification:
@@ -396,12 +492,28 @@ func Parse(b []byte) (Message, error) {
 		m = &ChangeNotificationRequest{}
 	case MsgTypeChangeNotificationResponse:
 		m = &ChangeNotificationResponse{}
+	case MsgTypeRemoteUEReportNotification:
+		m = &RemoteUEReportNotification{}
+	case MsgTypeRemoteUEReportAcknowledge:
+		m = &RemoteUEReportAcknowledge{}
 	case MsgTypeDownlinkDataNotification:
 		m = &DownlinkDataNotification{}
 	case MsgTypeDownlinkDataNotificationAcknowledge:
 		m = &DownlinkDataNotificationAcknowledge{}
 	case MsgTypeDownlinkDataNotificationFailureIndication:
 		m = &DownlinkDataNotificationFailureIndication{}
+	case MsgTypeMBMSSessionStartRequest:
+		m = &MBMSSessionStartRequest{}
+	case MsgTypeMBMSSessionStartResponse:
+		m = &MBMSSessionStartResponse{}
+	case MsgTypeMBMSSessionUpdateRequest:
+		m = &MBMSSessionUpdateRequest{}
+	case MsgTypeMBMSSessionUpdateResponse:
+		m = &MBMSSessionUpdateResponse{}
+	case MsgTypeMBMSSessionStopRequest:
+		m = &MBMSSessionStopRequest{}
+	case MsgTypeMBMSSessionStopResponse:
+		m = &MBMSSessionStopResponse{}
 	default:
 		m = &Generic{}
 	}
@@ -409,6 +521,23 @@ func Parse(b []byte) (Message, error) {
 	if err := m.UnmarshalBinary(b); err != nil {
 		return nil, fmt.Errorf("failed to decode GTPv2 Message: %w", err)
 	}
+
+	// the piggybacked message follows the message if P flag is set. Only one
+	// message can be piggybacked, and it must not have P flag set.
+	// TS 29.274 5.5.1 Piggybacking of Initial Messages
+	if (b[0]>>4)&0x01 == 1 {
+		offset := fixedHeaderSize + int(binary.BigEndian.Uint16(b[2:4]))
+		if len(b) > offset {
+			if (b[offset]>>4)&0x01 == 1 {
+				return nil, ErrNestedPiggyback
+			}
+			p, err := Parse(b[offset:])
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode piggybacked GTPv2 Message: %w", err)
+			}
+			m.SetPiggybacked(p)
+		}
+	}
 	return m, nil
 }
 
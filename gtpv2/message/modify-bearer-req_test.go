@@ -6,6 +6,7 @@ package message_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/wmnsk/go-gtp/gtpv2"
 	"github.com/wmnsk/go-gtp/gtpv2/ie"
@@ -45,6 +46,54 @@ func TestModifyBearerRequest(t *testing.T) {
 				0x57, 0x00, 0x09, 0x00, 0x80, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x04,
 			},
 		},
+		{
+			Description: "WithSecondaryRATUsageDataReport",
+			Structured: message.NewModifyBearerRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewRATType(gtpv2.RATTypeEUTRAN),
+				ie.NewSecondaryRATUsageDataReport(
+					0, 1, gtpv2.SecondaryRATTypeNR, 5,
+					time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, time.January, 1, 1, 0, 0, 0, time.UTC),
+					1000000, 500000,
+				),
+				ie.NewSecondaryRATUsageDataReport(
+					1, 1, gtpv2.SecondaryRATTypeNR, 6,
+					time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2019, time.January, 1, 1, 0, 0, 0, time.UTC),
+					0x2000, 0x1000,
+				),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x22, 0x00, 0x4b, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// RAT Type
+				0x52, 0x00, 0x01, 0x00, 0x06,
+				// Secondary RAT Usage Data Report
+				0xc9, 0x00, 0x1b, 0x00, 0x01, 0x00, 0x05, 0xdf, 0xd5, 0x2c, 0x00, 0xdf, 0xd5, 0x3a, 0x10, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x0f, 0x42, 0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0xa1, 0x20,
+				// Secondary RAT Usage Data Report
+				0xc9, 0x00, 0x1b, 0x00, 0x03, 0x00, 0x06, 0xdf, 0xd5, 0x2c, 0x00, 0xdf, 0xd5, 0x3a, 0x10, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00,
+			},
+		},
+		{
+			Description: "WithCIoT",
+			Structured: message.NewModifyBearerRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewRATType(gtpv2.RATTypeEUTRANNBIoT),
+				ie.NewServingPLMNRateControl(0x1111, 0x2222),
+				ie.NewCounter(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), 1),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x22, 0x00, 0x1e, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// RAT Type
+				0x52, 0x00, 0x01, 0x00, 0x08,
+				// Serving PLMN Rate Control
+				0xc6, 0x00, 0x04, 0x00, 0x11, 0x11, 0x22, 0x22,
+				// MO Exception Data Counter
+				0xc7, 0x00, 0x05, 0x00, 0xdf, 0xd5, 0x2c, 0x00, 0x01,
+			},
+		},
 	}
 
 	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
@@ -0,0 +1,101 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+func TestPiggybacked(t *testing.T) {
+	serialized := []byte{
+		// Create Session Response, with P flag
+		0x58, 0x21, 0x00, 0x0e, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+		//   Cause
+		0x02, 0x00, 0x02, 0x00, 0x10, 0x00,
+		// Create Bearer Request
+		0x48, 0x5f, 0x00, 0x0d, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x02, 0x00,
+		//   EBI
+		0x49, 0x00, 0x01, 0x00, 0x05,
+	}
+
+	res := message.NewCreateSessionResponse(
+		0x11223344, 1, ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+	)
+	res.SetPiggybacked(message.NewCreateBearerRequest(0x11223344, 2, ie.NewEPSBearerID(5)))
+
+	t.Run("Marshal", func(t *testing.T) {
+		b, err := message.Marshal(res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(b, serialized); diff != "" {
+			t.Error(diff)
+		}
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		msg, err := message.Parse(serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := msg.(*message.CreateSessionResponse); !ok {
+			t.Fatalf("unexpected type: %T", msg)
+		}
+		if msg.MarshalLen() != 18 {
+			t.Errorf("unexpected length: got %d, want 18", msg.MarshalLen())
+		}
+
+		p, ok := msg.Piggybacked().(*message.CreateBearerRequest)
+		if !ok {
+			t.Fatalf("unexpected type of piggybacked message: %T", msg.Piggybacked())
+		}
+		if p.Sequence() != 2 {
+			t.Errorf("unexpected sequence: got %d, want 2", p.Sequence())
+		}
+		if ebi := p.LinkedEBI.MustEPSBearerID(); ebi != 5 {
+			t.Errorf("unexpected EBI: got %d, want 5", ebi)
+		}
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		res.SetPiggybacked(nil)
+		if res.IsPiggybacking() {
+			t.Error("P flag should be cleared")
+		}
+		b, err := message.Marshal(res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) != 18 || b[0] != 0x48 {
+			t.Errorf("unexpected result: %x", b)
+		}
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		nested := make([]byte, len(serialized))
+		copy(nested, serialized)
+		// set P flag on the piggybacked message and add another one after it.
+		nested[18] |= 0x10
+		nested = append(nested, serialized[18:]...)
+
+		if _, err := message.Parse(nested); !errors.Is(err, message.ErrNestedPiggyback) {
+			t.Errorf("unexpected error: got %v, want %v", err, message.ErrNestedPiggyback)
+		}
+
+		p := message.NewCreateBearerRequest(0x11223344, 2, ie.NewEPSBearerID(5))
+		p.SetPiggybacked(message.NewCreateBearerRequest(0x11223344, 3, ie.NewEPSBearerID(6)))
+		res.SetPiggybacked(p)
+		if _, err := message.Marshal(res); !errors.Is(err, message.ErrNestedPiggyback) {
+			t.Errorf("unexpected error: got %v, want %v", err, message.ErrNestedPiggyback)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// RelocationCancelRequest is a RelocationCancelRequest Header and its IEs above.
+type RelocationCancelRequest struct {
+	*Header
+	IMSI             *ie.IE
+	MEI              *ie.IE
+	IndicationFlags  *ie.IE
+	RANAPCause       *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewRelocationCancelRequest creates a new RelocationCancelRequest.
+func NewRelocationCancelRequest(teid, seq uint32, ies ...*ie.IE) *RelocationCancelRequest {
+	r := &RelocationCancelRequest{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeRelocationCancelRequest, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			r.IMSI = i
+		case ie.MobileEquipmentIdentity:
+			r.MEI = i
+		case ie.Indication:
+			r.IndicationFlags = i
+		case ie.FCause:
+			r.RANAPCause = i
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	r.SetLength()
+	return r
+}
+
+// Marshal serializes RelocationCancelRequest into bytes.
+func (r *RelocationCancelRequest) Marshal() ([]byte, error) {
+	b := make([]byte, r.MarshalLen())
+	if err := r.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes RelocationCancelRequest into bytes.
+func (r *RelocationCancelRequest) MarshalTo(b []byte) error {
+	if r.Header.Payload != nil {
+		r.Header.Payload = nil
+	}
+	r.Header.Payload = make([]byte, r.MarshalLen()-r.Header.MarshalLen())
+
+	offset := 0
+	if ie := r.IMSI; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.MEI; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.IndicationFlags; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.RANAPCause; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(r.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	r.Header.SetLength()
+	return r.Header.MarshalTo(b)
+}
+
+// ParseRelocationCancelRequest decodes given bytes as RelocationCancelRequest.
+func ParseRelocationCancelRequest(b []byte) (*RelocationCancelRequest, error) {
+	r := &RelocationCancelRequest{}
+	if err := r.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UnmarshalBinary decodes given bytes as RelocationCancelRequest.
+func (r *RelocationCancelRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	r.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(r.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(r.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			r.IMSI = i
+		case ie.MobileEquipmentIdentity:
+			r.MEI = i
+		case ie.Indication:
+			r.IndicationFlags = i
+		case ie.FCause:
+			r.RANAPCause = i
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (r *RelocationCancelRequest) MarshalLen() int {
+	l := r.Header.MarshalLen() - len(r.Header.Payload)
+
+	if ie := r.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.MEI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.IndicationFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.RANAPCause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (r *RelocationCancelRequest) SetLength() {
+	r.Header.Length = uint16(r.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (r *RelocationCancelRequest) MessageTypeName() string {
+	return "Relocation Cancel Request"
+}
+
+// TEID returns the TEID in uint32.
+func (r *RelocationCancelRequest) TEID() uint32 {
+	return r.Header.teid()
+}
@@ -0,0 +1,188 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// RemoteUEReportNotification is a RemoteUEReportNotification Header and its IEs above.
+type RemoteUEReportNotification struct {
+	*Header
+	RemoteUEContextConnected    []*ie.IE
+	RemoteUEContextDisconnected []*ie.IE
+	PrivateExtension            *ie.IE
+	AdditionalIEs               []*ie.IE
+}
+
+// NewRemoteUEReportNotification creates a new RemoteUEReportNotification.
+func NewRemoteUEReportNotification(teid, seq uint32, ies ...*ie.IE) *RemoteUEReportNotification {
+	r := &RemoteUEReportNotification{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeRemoteUEReportNotification, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RemoteUEContext:
+			switch i.Instance() {
+			case 0:
+				r.RemoteUEContextConnected = append(r.RemoteUEContextConnected, i)
+			case 1:
+				r.RemoteUEContextDisconnected = append(r.RemoteUEContextDisconnected, i)
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	r.SetLength()
+	return r
+}
+
+// Marshal serializes RemoteUEReportNotification into bytes.
+func (r *RemoteUEReportNotification) Marshal() ([]byte, error) {
+	b := make([]byte, r.MarshalLen())
+	if err := r.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes RemoteUEReportNotification into bytes.
+func (r *RemoteUEReportNotification) MarshalTo(b []byte) error {
+	if r.Header.Payload != nil {
+		r.Header.Payload = nil
+	}
+	r.Header.Payload = make([]byte, r.MarshalLen()-r.Header.MarshalLen())
+
+	offset := 0
+	for _, ie := range r.RemoteUEContextConnected {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	for _, ie := range r.RemoteUEContextDisconnected {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(r.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(r.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	r.Header.SetLength()
+	return r.Header.MarshalTo(b)
+}
+
+// ParseRemoteUEReportNotification decodes given bytes as RemoteUEReportNotification.
+func ParseRemoteUEReportNotification(b []byte) (*RemoteUEReportNotification, error) {
+	r := &RemoteUEReportNotification{}
+	if err := r.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UnmarshalBinary decodes given bytes as RemoteUEReportNotification.
+func (r *RemoteUEReportNotification) UnmarshalBinary(b []byte) error {
+	var err error
+	r.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(r.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(r.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.RemoteUEContext:
+			switch i.Instance() {
+			case 0:
+				r.RemoteUEContextConnected = append(r.RemoteUEContextConnected, i)
+			case 1:
+				r.RemoteUEContextDisconnected = append(r.RemoteUEContextDisconnected, i)
+			default:
+				r.AdditionalIEs = append(r.AdditionalIEs, i)
+			}
+		case ie.PrivateExtension:
+			r.PrivateExtension = i
+		default:
+			r.AdditionalIEs = append(r.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (r *RemoteUEReportNotification) MarshalLen() int {
+	l := r.Header.MarshalLen() - len(r.Header.Payload)
+
+	for _, ie := range r.RemoteUEContextConnected {
+		l += ie.MarshalLen()
+	}
+	for _, ie := range r.RemoteUEContextDisconnected {
+		l += ie.MarshalLen()
+	}
+	if ie := r.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range r.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (r *RemoteUEReportNotification) SetLength() {
+	r.Header.Length = uint16(r.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (r *RemoteUEReportNotification) MessageTypeName() string {
+	return "Remote UE Report Notification"
+}
+
+// TEID returns the TEID in uint32.
+func (r *RemoteUEReportNotification) TEID() uint32 {
+	return r.Header.teid()
+}
@@ -0,0 +1,49 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestRemoteUEReportNotification(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewRemoteUEReportNotification(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewRemoteUEContext(
+					ie.NewRemoteUserID("123451234567890", "819012345678", "123450123456789"),
+					ie.NewRemoteUEIPinformation([]byte{0x01, 0x7f, 0x00, 0x00, 0x01}),
+				),
+				ie.NewRemoteUEContext(ie.NewRemoteUserID("123451234567890", "", ""), nil).WithInstance(1),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x28, 0x00, 0x45, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// Remote UE Context Connected
+				0xbf, 0x00, 0x27, 0x00, 0xc0, 0x00, 0x1a, 0x00, 0x03, 0x08, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76,
+				0x98, 0xf0, 0x06, 0x18, 0x09, 0x21, 0x43, 0x65, 0x87, 0x08, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65,
+				0x87, 0xf9, 0xc1, 0x00, 0x05, 0x00, 0x01, 0x7f, 0x00, 0x00, 0x01,
+				// Remote UE Context Disconnected
+				0xbf, 0x00, 0x0e, 0x01, 0xc0, 0x00, 0x0a, 0x00, 0x00, 0x08, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76,
+				0x98, 0xf0,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseRemoteUEReportNotification(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,178 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// SRVCCCsToPsCancelAcknowledge is a SRVCCCsToPsCancelAcknowledge Header and its IEs above.
+type SRVCCCsToPsCancelAcknowledge struct {
+	*Header
+	Cause            *ie.IE
+	SvFlags          *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewSRVCCCsToPsCancelAcknowledge creates a new SRVCCCsToPsCancelAcknowledge.
+func NewSRVCCCsToPsCancelAcknowledge(teid, seq uint32, ies ...*ie.IE) *SRVCCCsToPsCancelAcknowledge {
+	s := &SRVCCCsToPsCancelAcknowledge{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeSRVCCCsToPsCancelAcknowledge, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			s.Cause = i
+		case ie.SvFlags:
+			s.SvFlags = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	s.SetLength()
+	return s
+}
+
+// Marshal serializes SRVCCCsToPsCancelAcknowledge into bytes.
+func (s *SRVCCCsToPsCancelAcknowledge) Marshal() ([]byte, error) {
+	b := make([]byte, s.MarshalLen())
+	if err := s.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes SRVCCCsToPsCancelAcknowledge into bytes.
+func (s *SRVCCCsToPsCancelAcknowledge) MarshalTo(b []byte) error {
+	if s.Header.Payload != nil {
+		s.Header.Payload = nil
+	}
+	s.Header.Payload = make([]byte, s.MarshalLen()-s.Header.MarshalLen())
+
+	offset := 0
+	if ie := s.Cause; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.SvFlags; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(s.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(s.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	s.Header.SetLength()
+	return s.Header.MarshalTo(b)
+}
+
+// ParseSRVCCCsToPsCancelAcknowledge decodes given bytes as SRVCCCsToPsCancelAcknowledge.
+func ParseSRVCCCsToPsCancelAcknowledge(b []byte) (*SRVCCCsToPsCancelAcknowledge, error) {
+	s := &SRVCCCsToPsCancelAcknowledge{}
+	if err := s.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmarshalBinary decodes given bytes as SRVCCCsToPsCancelAcknowledge.
+func (s *SRVCCCsToPsCancelAcknowledge) UnmarshalBinary(b []byte) error {
+	var err error
+	s.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(s.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(s.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			s.Cause = i
+		case ie.SvFlags:
+			s.SvFlags = i
+		case ie.PrivateExtension:
+			s.PrivateExtension = i
+		default:
+			s.AdditionalIEs = append(s.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (s *SRVCCCsToPsCancelAcknowledge) MarshalLen() int {
+	l := s.Header.MarshalLen() - len(s.Header.Payload)
+
+	if ie := s.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.SvFlags; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := s.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range s.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (s *SRVCCCsToPsCancelAcknowledge) SetLength() {
+	s.Header.Length = uint16(s.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (s *SRVCCCsToPsCancelAcknowledge) MessageTypeName() string {
+	return "SRVCC CS to PS Cancel Acknowledge"
+}
+
+// TEID returns the TEID in uint32.
+func (s *SRVCCCsToPsCancelAcknowledge) TEID() uint32 {
+	return s.Header.teid()
+}
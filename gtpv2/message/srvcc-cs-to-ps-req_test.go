@@ -0,0 +1,73 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestSRVCCCsToPsRequest(t *testing.T) {
+	var (
+		ck = []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+		ik = []byte{0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20}
+		kc = []byte{0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28}
+	)
+
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewSRVCCCsToPsRequest(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewIMSI("123451234567890"),
+				ie.NewMobileEquipmentIdentity("123450123456789"),
+				ie.NewFullyQualifiedTEID(gtpv2.IFTypeS11MMEGTPC, 0xffffffff, "1.1.1.1", ""),
+				ie.NewMMContextForCSToPSSRVCC(1, ck, ik, kc, 2),
+				ie.NewSourceToTargetTransparentContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+				ie.NewTargetIdentificationMacroENodeBID("123", "45", 0x11111, 0x2222),
+				ie.NewTargetRNCID("123", "45", 0x1111, 0x22, 0x3333, 0),
+				ie.NewSourceRNCPDCPContextInfo([]byte{0xde, 0xad, 0xbe, 0xef}),
+				ie.NewSvFlags(0, 1, 1),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x1f, 0x00, 0x8a, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// IMSI
+				0x01, 0x00, 0x08, 0x00, 0x21, 0x43, 0x15, 0x32, 0x54, 0x76, 0x98, 0xf0,
+				// MEI
+				0x4b, 0x00, 0x08, 0x00, 0x21, 0x43, 0x05, 0x21, 0x43, 0x65, 0x87, 0xf9,
+				// Sender F-TEID for Control Plane
+				0x57, 0x00, 0x09, 0x00, 0x8a, 0xff, 0xff, 0xff, 0xff, 0x01, 0x01, 0x01, 0x01,
+				// MM Context for CS to PS SRVCC
+				0x3e, 0x00, 0x2a, 0x00, 0x01, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b,
+				0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b,
+				0x1c, 0x1d, 0x1e, 0x1f, 0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x02,
+				// Source to Target Transparent Container
+				0x34, 0x00, 0x05, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
+				// Target Identification
+				0x79, 0x00, 0x09, 0x00, 0x01, 0x21, 0xf3, 0x54, 0x01, 0x11, 0x11, 0x22, 0x22,
+				// Target RNC ID
+				0x39, 0x00, 0x08, 0x00, 0x21, 0xf3, 0x54, 0x11, 0x11, 0x22, 0x33, 0x33,
+				// Source RNC PDCP Context Info
+				0x7d, 0x00, 0x04, 0x00, 0xde, 0xad, 0xbe, 0xef,
+				// Sv Flags
+				0x3c, 0x00, 0x01, 0x00, 0x03,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseSRVCCCsToPsRequest(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
@@ -0,0 +1,50 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message_test
+
+import (
+	"testing"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+	"github.com/wmnsk/go-gtp/gtpv2/testutils"
+)
+
+func TestSRVCCPsToCsResponse(t *testing.T) {
+	cases := []testutils.TestCase{
+		{
+			Description: "Normal",
+			Structured: message.NewSRVCCPsToCsResponse(
+				testutils.TestBearerInfo.TEID, testutils.TestBearerInfo.Seq,
+				ie.NewCause(gtpv2.CauseRequestAccepted, 0, 0, 0, nil),
+				ie.NewSRVCCCause(gtpv2.SRVCCCauseUnspecified),
+				ie.NewTargetToSourceTransparentContainer([]byte{0xde, 0xad, 0xbe, 0xef}),
+				ie.NewTEIDC(0x11223344),
+			),
+			Serialized: []byte{
+				// Header
+				0x48, 0x1a, 0x00, 0x24, 0x11, 0x22, 0x33, 0x44, 0x00, 0x00, 0x01, 0x00,
+				// Cause
+				0x02, 0x00, 0x02, 0x00, 0x10, 0x00,
+				// SRVCC Cause
+				0x38, 0x00, 0x01, 0x00, 0x01,
+				// Target to Source Transparent Container
+				0x35, 0x00, 0x05, 0x00, 0x04, 0xde, 0xad, 0xbe, 0xef,
+				// TEID-C
+				0x3b, 0x00, 0x04, 0x00, 0x11, 0x22, 0x33, 0x44,
+			},
+		},
+	}
+
+	testutils.Run(t, cases, func(b []byte) (testutils.Serializable, error) {
+		v, err := message.ParseSRVCCPsToCsResponse(b)
+		if err != nil {
+			return nil, err
+		}
+		v.Payload = nil
+		return v, nil
+	})
+}
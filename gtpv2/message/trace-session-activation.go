@@ -0,0 +1,192 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// TraceSessionActivation is a TraceSessionActivation Header and its IEs above.
+type TraceSessionActivation struct {
+	*Header
+	IMSI             *ie.IE
+	TraceInformation *ie.IE
+	MEI              *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewTraceSessionActivation creates a new TraceSessionActivation.
+func NewTraceSessionActivation(teid, seq uint32, ies ...*ie.IE) *TraceSessionActivation {
+	t := &TraceSessionActivation{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeTraceSessionActivation, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			t.IMSI = i
+		case ie.TraceInformation:
+			t.TraceInformation = i
+		case ie.MobileEquipmentIdentity:
+			t.MEI = i
+		case ie.PrivateExtension:
+			t.PrivateExtension = i
+		default:
+			t.AdditionalIEs = append(t.AdditionalIEs, i)
+		}
+	}
+
+	t.SetLength()
+	return t
+}
+
+// Marshal serializes TraceSessionActivation into bytes.
+func (t *TraceSessionActivation) Marshal() ([]byte, error) {
+	b := make([]byte, t.MarshalLen())
+	if err := t.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes TraceSessionActivation into bytes.
+func (t *TraceSessionActivation) MarshalTo(b []byte) error {
+	if t.Header.Payload != nil {
+		t.Header.Payload = nil
+	}
+	t.Header.Payload = make([]byte, t.MarshalLen()-t.Header.MarshalLen())
+
+	offset := 0
+	if ie := t.IMSI; ie != nil {
+		if err := ie.MarshalTo(t.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := t.TraceInformation; ie != nil {
+		if err := ie.MarshalTo(t.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := t.MEI; ie != nil {
+		if err := ie.MarshalTo(t.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := t.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(t.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range t.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(t.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	t.Header.SetLength()
+	return t.Header.MarshalTo(b)
+}
+
+// ParseTraceSessionActivation decodes given bytes as TraceSessionActivation.
+func ParseTraceSessionActivation(b []byte) (*TraceSessionActivation, error) {
+	t := &TraceSessionActivation{}
+	if err := t.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// UnmarshalBinary decodes given bytes as TraceSessionActivation.
+func (t *TraceSessionActivation) UnmarshalBinary(b []byte) error {
+	var err error
+	t.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(t.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(t.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			t.IMSI = i
+		case ie.TraceInformation:
+			t.TraceInformation = i
+		case ie.MobileEquipmentIdentity:
+			t.MEI = i
+		case ie.PrivateExtension:
+			t.PrivateExtension = i
+		default:
+			t.AdditionalIEs = append(t.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (t *TraceSessionActivation) MarshalLen() int {
+	l := t.Header.MarshalLen() - len(t.Header.Payload)
+
+	if ie := t.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := t.TraceInformation; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := t.MEI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := t.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range t.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (t *TraceSessionActivation) SetLength() {
+	t.Header.Length = uint16(t.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (t *TraceSessionActivation) MessageTypeName() string {
+	return "Trace Session Activation"
+}
+
+// TEID returns the TEID in uint32.
+func (t *TraceSessionActivation) TEID() uint32 {
+	return t.Header.teid()
+}
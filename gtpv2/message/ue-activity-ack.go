@@ -0,0 +1,164 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// UEActivityAcknowledge is a UEActivityAcknowledge Header and its IEs above.
+type UEActivityAcknowledge struct {
+	*Header
+	Cause            *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewUEActivityAcknowledge creates a new UEActivityAcknowledge.
+func NewUEActivityAcknowledge(teid, seq uint32, ies ...*ie.IE) *UEActivityAcknowledge {
+	u := &UEActivityAcknowledge{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeUEActivityAcknowledge, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			u.Cause = i
+		case ie.PrivateExtension:
+			u.PrivateExtension = i
+		default:
+			u.AdditionalIEs = append(u.AdditionalIEs, i)
+		}
+	}
+
+	u.SetLength()
+	return u
+}
+
+// Marshal serializes UEActivityAcknowledge into bytes.
+func (u *UEActivityAcknowledge) Marshal() ([]byte, error) {
+	b := make([]byte, u.MarshalLen())
+	if err := u.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes UEActivityAcknowledge into bytes.
+func (u *UEActivityAcknowledge) MarshalTo(b []byte) error {
+	if u.Header.Payload != nil {
+		u.Header.Payload = nil
+	}
+	u.Header.Payload = make([]byte, u.MarshalLen()-u.Header.MarshalLen())
+
+	offset := 0
+	if ie := u.Cause; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range u.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(u.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	u.Header.SetLength()
+	return u.Header.MarshalTo(b)
+}
+
+// ParseUEActivityAcknowledge decodes given bytes as UEActivityAcknowledge.
+func ParseUEActivityAcknowledge(b []byte) (*UEActivityAcknowledge, error) {
+	u := &UEActivityAcknowledge{}
+	if err := u.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// UnmarshalBinary decodes given bytes as UEActivityAcknowledge.
+func (u *UEActivityAcknowledge) UnmarshalBinary(b []byte) error {
+	var err error
+	u.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(u.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(u.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.Cause:
+			u.Cause = i
+		case ie.PrivateExtension:
+			u.PrivateExtension = i
+		default:
+			u.AdditionalIEs = append(u.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (u *UEActivityAcknowledge) MarshalLen() int {
+	l := u.Header.MarshalLen() - len(u.Header.Payload)
+
+	if ie := u.Cause; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range u.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (u *UEActivityAcknowledge) SetLength() {
+	u.Header.Length = uint16(u.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (u *UEActivityAcknowledge) MessageTypeName() string {
+	return "UE Activity Acknowledge"
+}
+
+// TEID returns the TEID in uint32.
+func (u *UEActivityAcknowledge) TEID() uint32 {
+	return u.Header.teid()
+}
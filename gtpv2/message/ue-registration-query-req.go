@@ -0,0 +1,164 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package message
+
+import (
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// UERegistrationQueryRequest is a UERegistrationQueryRequest Header and its IEs above.
+type UERegistrationQueryRequest struct {
+	*Header
+	IMSI             *ie.IE
+	PrivateExtension *ie.IE
+	AdditionalIEs    []*ie.IE
+}
+
+// NewUERegistrationQueryRequest creates a new UERegistrationQueryRequest.
+func NewUERegistrationQueryRequest(teid, seq uint32, ies ...*ie.IE) *UERegistrationQueryRequest {
+	u := &UERegistrationQueryRequest{
+		Header: NewHeader(
+			NewHeaderFlags(2, 0, 1),
+			MsgTypeUERegistrationQueryRequest, teid, seq, nil,
+		),
+	}
+
+	for _, i := range ies {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			u.IMSI = i
+		case ie.PrivateExtension:
+			u.PrivateExtension = i
+		default:
+			u.AdditionalIEs = append(u.AdditionalIEs, i)
+		}
+	}
+
+	u.SetLength()
+	return u
+}
+
+// Marshal serializes UERegistrationQueryRequest into bytes.
+func (u *UERegistrationQueryRequest) Marshal() ([]byte, error) {
+	b := make([]byte, u.MarshalLen())
+	if err := u.MarshalTo(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// MarshalTo serializes UERegistrationQueryRequest into bytes.
+func (u *UERegistrationQueryRequest) MarshalTo(b []byte) error {
+	if u.Header.Payload != nil {
+		u.Header.Payload = nil
+	}
+	u.Header.Payload = make([]byte, u.MarshalLen()-u.Header.MarshalLen())
+
+	offset := 0
+	if ie := u.IMSI; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+	if ie := u.PrivateExtension; ie != nil {
+		if err := ie.MarshalTo(u.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	for _, ie := range u.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		if err := ie.MarshalTo(u.Header.Payload[offset:]); err != nil {
+			return err
+		}
+		offset += ie.MarshalLen()
+	}
+
+	u.Header.SetLength()
+	return u.Header.MarshalTo(b)
+}
+
+// ParseUERegistrationQueryRequest decodes given bytes as UERegistrationQueryRequest.
+func ParseUERegistrationQueryRequest(b []byte) (*UERegistrationQueryRequest, error) {
+	u := &UERegistrationQueryRequest{}
+	if err := u.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// UnmarshalBinary decodes given bytes as UERegistrationQueryRequest.
+func (u *UERegistrationQueryRequest) UnmarshalBinary(b []byte) error {
+	var err error
+	u.Header, err = ParseHeader(b)
+	if err != nil {
+		return err
+	}
+	if len(u.Header.Payload) < 2 {
+		return nil
+	}
+
+	decodedIEs, err := ie.ParseMultiIEs(u.Header.Payload)
+	if err != nil {
+		return err
+	}
+	for _, i := range decodedIEs {
+		if i == nil {
+			continue
+		}
+		switch i.Type {
+		case ie.IMSI:
+			u.IMSI = i
+		case ie.PrivateExtension:
+			u.PrivateExtension = i
+		default:
+			u.AdditionalIEs = append(u.AdditionalIEs, i)
+		}
+	}
+
+	return nil
+}
+
+// MarshalLen returns the serial length in int.
+func (u *UERegistrationQueryRequest) MarshalLen() int {
+	l := u.Header.MarshalLen() - len(u.Header.Payload)
+
+	if ie := u.IMSI; ie != nil {
+		l += ie.MarshalLen()
+	}
+	if ie := u.PrivateExtension; ie != nil {
+		l += ie.MarshalLen()
+	}
+
+	for _, ie := range u.AdditionalIEs {
+		if ie == nil {
+			continue
+		}
+		l += ie.MarshalLen()
+	}
+	return l
+}
+
+// SetLength sets the length in Length field.
+func (u *UERegistrationQueryRequest) SetLength() {
+	u.Header.Length = uint16(u.MarshalLen() - 4)
+}
+
+// MessageTypeName returns the name of protocol.
+func (u *UERegistrationQueryRequest) MessageTypeName() string {
+	return "UE Registration Query Request"
+}
+
+// TEID returns the TEID in uint32.
+func (u *UERegistrationQueryRequest) TEID() uint32 {
+	return u.Header.teid()
+}
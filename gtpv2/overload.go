@@ -0,0 +1,517 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"math"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+// overloadPeer is the overload and load information announced by a peer.
+type overloadPeer struct {
+	hasOverload bool
+	ociSequence uint32
+	reduction   uint8
+	// expiry is the end of the Period of Validity. Zero means infinite.
+	expiry time.Time
+
+	hasLoad     bool
+	lciSequence uint32
+	load        uint8
+}
+
+// currentReduction returns the Overload Reduction Metric if it is still valid.
+func (p *overloadPeer) currentReduction(now time.Time) uint8 {
+	if !p.hasOverload {
+		return 0
+	}
+	if !p.expiry.IsZero() && now.After(p.expiry) {
+		return 0
+	}
+	return p.reduction
+}
+
+// overloadManager keeps track of the Overload Control Information and Load Control
+// Information received from the peers, and throttles the initial messages toward
+// the overloaded peers.
+//
+// TS 29.274 12 GTP-C Load and Overload Control Mechanism
+type overloadManager struct {
+	mu      sync.Mutex
+	enabled bool
+	peers   map[string]*overloadPeer
+
+	// peerNode is the type of the peer node, which determines the IEs in messages
+	// that have the information of the peer itself.
+	peerNode overloadPeerNode
+
+	hasExemptPriority bool
+	exemptPriority    uint8
+}
+
+func newOverloadManager(localIfType uint8) *overloadManager {
+	o := &overloadManager{peers: map[string]*overloadPeer{}}
+
+	switch localIfType {
+	case IFTypeS11MMEGTPC, IFTypeS4SGSNGTPC, IFTypeS5S8PGWGTPC:
+		o.peerNode = overloadPeerSGW
+	case IFTypeS11S4SGWGTPC:
+		o.peerNode = overloadPeerMMESGSN
+	case IFTypeS5S8SGWGTPC, IFTypeS2bePDGGTPC, IFTypeS2aTWANGTPC:
+		o.peerNode = overloadPeerPGW
+	case IFTypeS2bPGWGTPC:
+		o.peerNode = overloadPeerEPDG
+	case IFTypeS2aPGWGTPC:
+		o.peerNode = overloadPeerTWAN
+	}
+
+	return o
+}
+
+func (o *overloadManager) setEnabled(enabled bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.enabled = enabled
+}
+
+func (o *overloadManager) setExemptPriority(priority uint8) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.hasExemptPriority = true
+	o.exemptPriority = priority
+}
+
+// observe updates the information of the peer with the message received from it.
+func (o *overloadManager) observe(addr net.Addr, msg message.Message) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !o.enabled {
+		return
+	}
+
+	oci, lci := overloadIEsOf(msg).of(o.peerNode)
+	if oci != nil {
+		o.updateOverload(addr, oci, time.Now())
+	}
+	if lci != nil {
+		o.updateLoad(addr, lci)
+	}
+}
+
+// serialNewer reports whether the Sequence Number a is newer than b, taking the
+// wraparound into account as described in RFC 1982.
+func serialNewer(a, b uint32) bool {
+	return int32(a-b) > 0
+}
+
+func (o *overloadManager) peer(addr net.Addr) *overloadPeer {
+	peer, ok := o.peers[addr.String()]
+	if !ok {
+		peer = &overloadPeer{}
+		o.peers[addr.String()] = peer
+	}
+	return peer
+}
+
+func (o *overloadManager) updateOverload(addr net.Addr, oci *ie.IE, now time.Time) {
+	ies, err := oci.OverloadControlInformation()
+	if err != nil {
+		logf("failed to decode Overload Control Information from %s: %v", addr, err)
+		return
+	}
+
+	var (
+		seq      uint32
+		metric   uint8
+		validity time.Duration
+	)
+	for _, i := range ies {
+		switch i.Type {
+		case ie.SequenceNumber:
+			seq, err = i.SequenceNumber()
+		case ie.Metric:
+			metric, err = i.Metric()
+		case ie.EPCTimer:
+			validity, err = i.EPCTimer()
+		case ie.AccessPointName:
+			// APN level overload control is not supported.
+			return
+		}
+		if err != nil {
+			logf("failed to decode Overload Control Information from %s: %v", addr, err)
+			return
+		}
+	}
+
+	peer := o.peer(addr)
+	// the information with older Sequence Number is ignored.
+	if peer.hasOverload && !serialNewer(seq, peer.ociSequence) {
+		return
+	}
+
+	peer.hasOverload = true
+	peer.ociSequence = seq
+	peer.reduction = metric
+	switch validity {
+	case time.Duration(math.MaxInt64):
+		peer.expiry = time.Time{}
+	case 0:
+		// the timer is stopped, which means the overload is over.
+		peer.reduction = 0
+		peer.expiry = time.Time{}
+	default:
+		peer.expiry = now.Add(validity)
+	}
+}
+
+func (o *overloadManager) updateLoad(addr net.Addr, lci *ie.IE) {
+	seq, err := lci.SequenceNumber()
+	if err != nil {
+		logf("failed to decode Load Control Information from %s: %v", addr, err)
+		return
+	}
+	metric, err := lci.Metric()
+	if err != nil {
+		logf("failed to decode Load Control Information from %s: %v", addr, err)
+		return
+	}
+
+	peer := o.peer(addr)
+	// the information with older Sequence Number is ignored.
+	if peer.hasLoad && !serialNewer(seq, peer.lciSequence) {
+		return
+	}
+
+	peer.hasLoad = true
+	peer.lciSequence = seq
+	peer.load = metric
+}
+
+// throttle reports whether the message to the peer should be discarded to reduce
+// the traffic as requested by the peer.
+func (o *overloadManager) throttle(addr net.Addr, msg message.Message) (uint8, bool) {
+	// Echo Request is not throttled to keep the path management working.
+	if !isInitialMessage(msg.MessageType()) || msg.MessageType() == message.MsgTypeEchoRequest {
+		return 0, false
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !o.enabled {
+		return 0, false
+	}
+	peer, ok := o.peers[addr.String()]
+	if !ok {
+		return 0, false
+	}
+	reduction := peer.currentReduction(time.Now())
+	if reduction == 0 {
+		return 0, false
+	}
+
+	if o.hasExemptPriority {
+		if h, ok := msg.(interface {
+			HasMessagePriority() bool
+			MessagePriority() uint8
+		}); ok && h.HasMessagePriority() && h.MessagePriority() <= o.exemptPriority {
+			return reduction, false
+		}
+	}
+
+	return reduction, rand.Intn(100) < int(reduction)
+}
+
+func (o *overloadManager) reduction(addr net.Addr) (uint8, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	peer, ok := o.peers[addr.String()]
+	if !ok || !peer.hasOverload {
+		return 0, false
+	}
+	return peer.currentReduction(time.Now()), true
+}
+
+func (o *overloadManager) load(addr net.Addr) (uint8, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	peer, ok := o.peers[addr.String()]
+	if !ok || !peer.hasLoad {
+		return 0, false
+	}
+	return peer.load, true
+}
+
+// weight returns the weight of the peer used in peer selection, which is in the
+// range of 0 to 10000.
+func (o *overloadManager) weight(addr net.Addr) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	peer, ok := o.peers[addr.String()]
+	if !ok {
+		return 100 * 100
+	}
+
+	var load int
+	if peer.hasLoad {
+		load = int(peer.load)
+	}
+	return (100 - load) * (100 - int(peer.currentReduction(time.Now())))
+}
+
+// overloadPeerNode is the type of the peer node of the overload control.
+type overloadPeerNode uint8
+
+const (
+	overloadPeerUnknown overloadPeerNode = iota
+	overloadPeerSGW
+	overloadPeerMMESGSN
+	overloadPeerPGW
+	overloadPeerEPDG
+	overloadPeerTWAN
+)
+
+// overloadIEs is the Overload Control Information and Load Control Information IEs
+// in a message, by the node that has sent them.
+type overloadIEs struct {
+	sgwOCI, sgwLCI *ie.IE
+	mmeOCI         *ie.IE
+	pgwOCI, pgwLCI *ie.IE
+	epdgOCI        *ie.IE
+	twanEPDGOCI    *ie.IE
+}
+
+// of returns the Overload Control Information and Load Control Information of the
+// node given. They can be nil.
+func (o overloadIEs) of(node overloadPeerNode) (oci, lci *ie.IE) {
+	switch node {
+	case overloadPeerSGW:
+		return o.sgwOCI, o.sgwLCI
+	case overloadPeerMMESGSN:
+		return o.mmeOCI, nil
+	case overloadPeerPGW:
+		return o.pgwOCI, o.pgwLCI
+	case overloadPeerEPDG:
+		if o.epdgOCI != nil {
+			return o.epdgOCI, nil
+		}
+		return o.twanEPDGOCI, nil
+	case overloadPeerTWAN:
+		return o.twanEPDGOCI, nil
+	}
+	return nil, nil
+}
+
+// overloadIEsOf returns the Overload Control Information and Load Control Information
+// IEs in the message.
+func overloadIEsOf(msg message.Message) overloadIEs {
+	switch m := msg.(type) {
+	case *message.BearerResourceCommand:
+		return overloadIEs{
+			mmeOCI: m.MMESGSNOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.BearerResourceFailureIndication:
+		return overloadIEs{
+			pgwOCI: m.PGWOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.CreateBearerRequest:
+		return overloadIEs{
+			pgwLCI: m.PGWNodeLoadControlInformation,
+			sgwLCI: m.SGWNodeLoadControlInformation,
+			pgwOCI: m.PGWOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.CreateBearerResponse:
+		return overloadIEs{
+			mmeOCI:      m.MMEOverloadControlInformation,
+			sgwOCI:      m.SGWOverloadControlInformation,
+			twanEPDGOCI: m.TWANePDGOverloadControlInformation,
+		}
+	case *message.CreateSessionRequest:
+		return overloadIEs{
+			mmeOCI:      m.MMESGSNOverloadControlInformation,
+			sgwOCI:      m.SGWOverloadControlInformation,
+			twanEPDGOCI: m.TWANePDGOverloadControlInformation,
+		}
+	case *message.CreateSessionResponse:
+		return overloadIEs{
+			pgwLCI: m.PGWNodeLoadControlInformation,
+			sgwLCI: m.SGWNodeLoadControlInformation,
+			pgwOCI: m.PGWOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.DeleteBearerCommand:
+		return overloadIEs{
+			mmeOCI: m.MMESGSNOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.DeleteBearerFailureIndication:
+		return overloadIEs{
+			pgwOCI: m.PGWOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.DeleteBearerRequest:
+		return overloadIEs{
+			pgwLCI: m.PGWNodeLoadControlInformation,
+			sgwLCI: m.SGWNodeLoadControlInformation,
+			pgwOCI: m.PGWOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.DeleteBearerResponse:
+		return overloadIEs{
+			mmeOCI:      m.MMEOverloadControlInformation,
+			sgwOCI:      m.SGWOverloadControlInformation,
+			twanEPDGOCI: m.TWANePDGOverloadControlInformation,
+		}
+	case *message.DeleteSessionRequest:
+		return overloadIEs{
+			mmeOCI:      m.MMESGSNOverloadControlInformation,
+			sgwOCI:      m.SGWOverloadControlInformaion,
+			twanEPDGOCI: m.TWANePDGOverloadControlInformaion,
+		}
+	case *message.DeleteSessionResponse:
+		return overloadIEs{
+			pgwLCI: m.PGWNodeLoadControlInformation,
+			sgwLCI: m.SGWNodeLoadControlInformation,
+			pgwOCI: m.PGWOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.DownlinkDataNotification:
+		return overloadIEs{
+			sgwLCI: m.SGWNodeLoadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.ModifyAccessBearersResponse:
+		return overloadIEs{
+			sgwLCI: m.SGWNodeLoadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.ModifyBearerCommand:
+		return overloadIEs{
+			mmeOCI:      m.MMESGSNOverloadControlInformation,
+			sgwOCI:      m.SGWOverloadControlInformation,
+			twanEPDGOCI: m.TWANePDGOverloadControlInformation,
+		}
+	case *message.ModifyBearerFailureIndication:
+		return overloadIEs{
+			pgwOCI: m.PGWOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.ModifyBearerRequest:
+		return overloadIEs{
+			mmeOCI:  m.MMESGSNOverloadControlInformation,
+			sgwOCI:  m.SGWOverloadControlInformation,
+			epdgOCI: m.EPDGOverloadControlInformation,
+		}
+	case *message.ModifyBearerResponse:
+		return overloadIEs{
+			pgwLCI: m.PGWNodeLoadControlInformation,
+			sgwLCI: m.SGWNodeLoadControlInformation,
+			pgwOCI: m.PGWOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.ReleaseAccessBearersResponse:
+		return overloadIEs{
+			sgwLCI: m.SGWNodeLoadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.UpdateBearerRequest:
+		return overloadIEs{
+			pgwLCI: m.PGWNodeLoadControlInformation,
+			sgwLCI: m.SGWNodeLoadControlInformation,
+			pgwOCI: m.PGWOverloadControlInformation,
+			sgwOCI: m.SGWOverloadControlInformation,
+		}
+	case *message.UpdateBearerResponse:
+		return overloadIEs{
+			mmeOCI:      m.MMESGSNOverloadControlInformation,
+			sgwOCI:      m.SGWOverloadControlInformation,
+			twanEPDGOCI: m.TWANePDGOverloadControlInformation,
+		}
+	}
+	return overloadIEs{}
+}
+
+// EnableOverloadControl turns on the GTP-C overload control defined in TS 29.274 12.3.
+//
+// Once enabled, Conn keeps track of the Overload Control Information and Load Control
+// Information sent by the peers, and the initial messages sent with SendMessageTo
+// (and the methods using it) toward an overloaded peer are discarded at the rate of
+// the Overload Reduction Metric announced by the peer, returning *PeerOverloadedError.
+//
+// Which IEs are taken as the ones of the peer depends on the interface type of Conn,
+// e.g., SGWOverloadControlInformation if it is MME on S11. APN level overload
+// control is not supported.
+func (c *Conn) EnableOverloadControl() {
+	c.overload.setEnabled(true)
+}
+
+// DisableOverloadControl turns off the GTP-C overload control.
+func (c *Conn) DisableOverloadControl() {
+	c.overload.setEnabled(false)
+}
+
+// SetOverloadExemptPriority makes the messages with MessagePriority in the header
+// that is equal to or less than (= higher than or equal in priority) the value given
+// never be discarded by the overload control.
+func (c *Conn) SetOverloadExemptPriority(priority uint8) {
+	c.overload.setExemptPriority(priority)
+}
+
+// PeerOverloadMetric returns the Overload Reduction Metric announced by the peer,
+// which is zero when the Period of Validity has expired.
+// The second returned value is false if no Overload Control Information has been
+// received from it.
+func (c *Conn) PeerOverloadMetric(raddr net.Addr) (uint8, bool) {
+	return c.overload.reduction(raddr)
+}
+
+// PeerLoadMetric returns the Load Metric announced by the peer.
+// The second returned value is false if no Load Control Information has been
+// received from it.
+func (c *Conn) PeerLoadMetric(raddr net.Addr) (uint8, bool) {
+	return c.overload.load(raddr)
+}
+
+// SelectPeer selects a peer from candidates at random, weighted by the spare capacity
+// of each peer, i.e., the less the Load Metric and Overload Reduction Metric announced
+// by the peer are, the more likely it is selected. The peers that have not announced
+// them are treated as having no load.
+//
+// ErrNoPeerAvailable is returned if all the candidates are fully loaded or overloaded.
+func (c *Conn) SelectPeer(candidates ...net.Addr) (net.Addr, error) {
+	weights := make([]int, len(candidates))
+	total := 0
+	for n, cand := range candidates {
+		weights[n] = c.overload.weight(cand)
+		total += weights[n]
+	}
+	if total == 0 {
+		return nil, ErrNoPeerAvailable
+	}
+
+	r := rand.Intn(total)
+	for n, w := range weights {
+		if r < w {
+			return candidates[n], nil
+		}
+		r -= w
+	}
+	return nil, ErrNoPeerAvailable
+}
@@ -0,0 +1,440 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+// pathPeer is the state of the path to a peer.
+type pathPeer struct {
+	addr net.Addr
+
+	// pending is true while the Echo Request sent is not answered.
+	pending bool
+	missed  int
+	down    bool
+
+	restartCounter uint8
+	hasCounter     bool
+}
+
+// pathManager sends Echo Request to each peer periodically, and detects the path
+// failure and the restart of the peer.
+//
+// TS 23.007 Restoration Procedures
+type pathManager struct {
+	mu    sync.Mutex
+	conn  *Conn
+	peers map[string]*pathPeer
+
+	echoFunc func(raddr net.Addr) error
+	closeCh  <-chan struct{}
+	stopCh   chan struct{}
+
+	peerDownHandler      PeerDownHandlerFunc
+	peerRestartedHandler PeerRestartedHandlerFunc
+}
+
+func newPathManager(conn *Conn, echoFunc func(raddr net.Addr) error, closeCh <-chan struct{}) *pathManager {
+	return &pathManager{
+		conn:     conn,
+		peers:    map[string]*pathPeer{},
+		echoFunc: echoFunc,
+		closeCh:  closeCh,
+	}
+}
+
+// start starts sending Echo Request to each peer every interval. The path is
+// considered down if n Echo Requests in a row are not answered.
+func (p *pathManager) start(interval time.Duration, n int) {
+	p.mu.Lock()
+	if p.stopCh != nil {
+		close(p.stopCh)
+	}
+	stopCh := make(chan struct{})
+	p.stopCh = stopCh
+	p.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-p.closeCh:
+				return
+			case <-ticker.C:
+				p.echo(n)
+			}
+		}
+	}()
+}
+
+func (p *pathManager) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.stopCh != nil {
+		close(p.stopCh)
+		p.stopCh = nil
+	}
+}
+
+func (p *pathManager) echo(n int) {
+	var targets, downs []net.Addr
+
+	p.mu.Lock()
+	for _, peer := range p.peers {
+		if peer.pending {
+			peer.missed++
+			if peer.missed >= n && !peer.down {
+				peer.down = true
+				downs = append(downs, peer.addr)
+			}
+		}
+		peer.pending = true
+		targets = append(targets, peer.addr)
+	}
+	handler := p.peerDownHandler
+	p.mu.Unlock()
+
+	for _, addr := range downs {
+		if handler == nil {
+			logf("path to %s is down", addr)
+			continue
+		}
+		handler(p.conn, addr)
+	}
+
+	for _, addr := range targets {
+		if err := p.echoFunc(addr); err != nil {
+			logf("failed to send Echo Request to %s: %v", addr, err)
+		}
+	}
+}
+
+func (p *pathManager) addPeer(addr net.Addr) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.peers[addr.String()]; !ok {
+		p.peers[addr.String()] = &pathPeer{addr: addr}
+	}
+}
+
+func (p *pathManager) removePeer(addr net.Addr) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.peers, addr.String())
+}
+
+// observe updates the state of the peer with the message received from it.
+// Any message received means the path is working.
+//
+// Unlike the Echo, the Restart Counter is tracked even if the path management is
+// disabled, so that the Sessions can be cleaned up on the restart of the peer.
+func (p *pathManager) observe(addr net.Addr, msg message.Message) {
+	p.mu.Lock()
+	peer, ok := p.peers[addr.String()]
+	if !ok {
+		peer = &pathPeer{addr: addr}
+		p.peers[addr.String()] = peer
+	}
+	peer.pending = false
+	peer.missed = 0
+	peer.down = false
+
+	counter, ok := restartCounterOf(msg)
+	if !ok {
+		p.mu.Unlock()
+		return
+	}
+	restarted := peer.hasCounter && peer.restartCounter != counter
+	peer.restartCounter = counter
+	peer.hasCounter = true
+	handler := p.peerRestartedHandler
+	p.mu.Unlock()
+
+	if !restarted {
+		return
+	}
+
+	p.conn.cleanupSessions(addr)
+	if handler == nil {
+		logf("peer %s has restarted, Restart Counter: %d", addr, counter)
+		return
+	}
+	handler(p.conn, addr, counter)
+}
+
+func (p *pathManager) restartCounter(addr net.Addr) (uint8, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	peer, ok := p.peers[addr.String()]
+	if !ok || !peer.hasCounter {
+		return 0, false
+	}
+	return peer.restartCounter, true
+}
+
+func (p *pathManager) isDown(addr net.Addr) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	peer, ok := p.peers[addr.String()]
+	if !ok {
+		return false
+	}
+	return peer.down
+}
+
+func (p *pathManager) setPeerDownHandler(fn PeerDownHandlerFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.peerDownHandler = fn
+}
+
+func (p *pathManager) setPeerRestartedHandler(fn PeerRestartedHandlerFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.peerRestartedHandler = fn
+}
+
+// restartCounterOf returns the Restart Counter in Recovery IE if the message has it.
+func restartCounterOf(msg message.Message) (uint8, bool) {
+	var i *ie.IE
+	switch m := msg.(type) {
+	case *message.EchoRequest:
+		i = m.Recovery
+	case *message.EchoResponse:
+		i = m.Recovery
+	case *message.CreateSessionRequest:
+		i = m.Recovery
+	case *message.CreateSessionResponse:
+		i = m.Recovery
+	case *message.ModifyBearerRequest:
+		i = m.Recovery
+	case *message.ModifyBearerResponse:
+		i = m.Recovery
+	case *message.DeleteSessionResponse:
+		i = m.Recovery
+	case *message.CreateBearerResponse:
+		i = m.Recovery
+	case *message.UpdateBearerResponse:
+		i = m.Recovery
+	case *message.DeleteBearerResponse:
+		i = m.Recovery
+	case *message.ModifyBearerFailureIndication:
+		i = m.Recovery
+	case *message.DeleteBearerFailureIndication:
+		i = m.Recovery
+	case *message.BearerResourceFailureIndication:
+		i = m.Recovery
+	case *message.DeletePDNConnectionSetResponse:
+		i = m.Recovery
+	case *message.UpdatePDNConnectionSetResponse:
+		i = m.Recovery
+	case *message.DetachAcknowledge:
+		i = m.Recovery
+	case *message.DownlinkDataNotificationAcknowledge:
+		i = m.Recovery
+	case *message.ModifyAccessBearersRequest:
+		i = m.Recovery
+	case *message.ModifyAccessBearersResponse:
+		i = m.Recovery
+	case *message.ReleaseAccessBearersResponse:
+		i = m.Recovery
+	case *message.ForwardRelocationRequest:
+		i = m.Recovery
+	case *message.ForwardRelocationCompleteAcknowledge:
+		i = m.Recovery
+	case *message.CreateIndirectDataForwardingTunnelRequest:
+		i = m.Recovery
+	case *message.CreateIndirectDataForwardingTunnelResponse:
+		i = m.Recovery
+	case *message.DeleteIndirectDataForwardingTunnelResponse:
+		i = m.Recovery
+	case *message.MBMSSessionStartRequest:
+		i = m.Recovery
+	case *message.MBMSSessionStartResponse:
+		i = m.Recovery
+	case *message.MBMSSessionUpdateResponse:
+		i = m.Recovery
+	case *message.MBMSSessionStopResponse:
+		i = m.Recovery
+	}
+	if i == nil {
+		return 0, false
+	}
+
+	counter, err := i.Recovery()
+	if err != nil {
+		return 0, false
+	}
+	return counter, true
+}
+
+// EnablePathManagement starts sending Echo Request to each peer of Conn every
+// interval. The path to the peer is considered down if n Echo Requests in a row are
+// not answered, and the handler set with SetPeerDownHandler is called.
+//
+// The peers are the ones added with AddPeer and the ones any message is received from.
+// The Restart Counter in Recovery IE received from the peers is tracked regardless of
+// the path management, and the handler set with SetPeerRestartedHandler is called when
+// it changes. See also EnableSessionCleanup.
+//
+// If the reliable delivery is enabled, Echo Requests are also retransmitted as
+// described in EnableReliableDelivery. Choose interval longer than T3*N3 in that case.
+//
+// It stops when the ctx given to Serve is canceled or Conn is closed.
+func (c *Conn) EnablePathManagement(interval time.Duration, n int) {
+	c.paths.start(interval, n)
+}
+
+// DisablePathManagement stops sending Echo Request to the peers.
+func (c *Conn) DisablePathManagement() {
+	c.paths.stop()
+}
+
+// AddPeer adds a peer to send Echo Request to when the path management is enabled.
+func (c *Conn) AddPeer(raddr net.Addr) {
+	c.paths.addPeer(raddr)
+}
+
+// RemovePeer removes a peer from the path management.
+func (c *Conn) RemovePeer(raddr net.Addr) {
+	c.paths.removePeer(raddr)
+}
+
+// PeerRestartCounter returns the last Restart Counter received from the peer.
+// The second returned value is false if no Recovery IE has been received from it.
+func (c *Conn) PeerRestartCounter(raddr net.Addr) (uint8, bool) {
+	return c.paths.restartCounter(raddr)
+}
+
+// IsPeerDown reports whether the path to the peer is considered down.
+func (c *Conn) IsPeerDown(raddr net.Addr) bool {
+	return c.paths.isDown(raddr)
+}
+
+// SetPeerDownHandler sets the handler called when the path to the peer is considered
+// down. If not set, it is just logged.
+func (c *Conn) SetPeerDownHandler(fn PeerDownHandlerFunc) {
+	c.paths.setPeerDownHandler(fn)
+}
+
+// SetPeerRestartedHandler sets the handler called when the peer is found to be restarted.
+// If not set, it is just logged.
+func (c *Conn) SetPeerRestartedHandler(fn PeerRestartedHandlerFunc) {
+	c.paths.setPeerRestartedHandler(fn)
+}
+
+// EnableSessionCleanup makes Conn remove all the Sessions with the peer when the peer
+// is found to be restarted, as required in TS 23.007. fn is called for each Session
+// removed, to release the resources associated with it. fn can be nil.
+//
+// The Sessions are removed before the handler set with SetPeerRestartedHandler is called.
+func (c *Conn) EnableSessionCleanup(fn SessionCleanupHandlerFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sessionCleanupEnabled = true
+	c.sessionCleanupHandler = fn
+}
+
+// DisableSessionCleanup stops removing the Sessions on the restart of the peer, which
+// is the default.
+func (c *Conn) DisableSessionCleanup() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sessionCleanupEnabled = false
+	c.sessionCleanupHandler = nil
+}
+
+// EnablePGWRestartNotification makes Conn send PGW Restart Notification over s11Conn
+// when the Sessions are cleaned up on the restart of the peer. This is meant to be
+// used on the S5/S8 Conn of S-GW, with the S11/S4 Conn given as s11Conn.
+//
+// The notification is sent once to each MME/SGSN that has the Session with the same
+// IMSI as the Session removed. It has no effect unless EnableSessionCleanup is called.
+//
+// sgwIP is the S-GW's IP address for S11/S4, which is sent in the notification. It
+// cannot be the unspecified address, as s11Conn may be listening on it.
+func (c *Conn) EnablePGWRestartNotification(s11Conn *Conn, sgwIP string) error {
+	if ip := net.ParseIP(sgwIP); ip == nil || ip.IsUnspecified() {
+		return fmt.Errorf("invalid S-GW IP address: %q", sgwIP)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.restartNotifyConn = s11Conn
+	c.restartNotifySGWIP = sgwIP
+	return nil
+}
+
+// DisablePGWRestartNotification stops sending PGW Restart Notification, which is the default.
+func (c *Conn) DisablePGWRestartNotification() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.restartNotifyConn = nil
+	c.restartNotifySGWIP = ""
+}
+
+func (c *Conn) cleanupSessions(peer net.Addr) {
+	c.mu.Lock()
+	enabled, fn, s11Conn, sgwIP := c.sessionCleanupEnabled, c.sessionCleanupHandler, c.restartNotifyConn, c.restartNotifySGWIP
+	c.mu.Unlock()
+	if !enabled {
+		return
+	}
+
+	mmes := map[string]net.Addr{}
+	for _, sess := range c.Sessions() {
+		if sess.peerAddrString != peer.String() {
+			continue
+		}
+		c.RemoveSession(sess)
+
+		if s11Conn != nil {
+			if s11Sess, err := s11Conn.GetSessionByIMSI(sess.IMSI); err == nil {
+				mmes[s11Sess.peerAddrString] = s11Sess.PeerAddr()
+			}
+		}
+		if fn != nil {
+			fn(c, sess)
+		}
+	}
+
+	if len(mmes) == 0 {
+		return
+	}
+
+	pgwIP, _, err := net.SplitHostPort(peer.String())
+	if err != nil {
+		logf("failed to send PGW Restart Notification: %v", err)
+		return
+	}
+	for _, mme := range mmes {
+		if _, err := s11Conn.PGWRestartNotification(mme, pgwIP, sgwIP); err != nil {
+			logf("failed to send PGW Restart Notification to %s: %v", mme, err)
+		}
+	}
+}
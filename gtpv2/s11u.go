@@ -0,0 +1,195 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"net"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv1"
+	v1msg "github.com/wmnsk/go-gtp/gtpv1/message"
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+)
+
+// s11uTunnel is the S11-U tunnel used to exchange the user data of a Session
+// with Control Plane CIoT EPS optimisation.
+type s11uTunnel struct {
+	uConn   *gtpv1.UPlaneConn
+	teidIn  uint32
+	teidOut uint32
+	raddr   net.Addr
+
+	// rxCh is the channel to store user data received on the tunnel.
+	rxCh chan []byte
+}
+
+// s11uKey is the key of (*Conn).s11uTunnels.
+type s11uKey struct {
+	uConn *gtpv1.UPlaneConn
+	teid  uint32
+}
+
+// CreateS11UTunnel allocates a new S11-U MME F-TEID on uConn for the Session.
+//
+// The returned F-TEID is ready to be set in the Bearer Context in Create Session
+// Request or Modify Bearer Request. To receive the user data with ReadS11UData,
+// EnableS11U should be called with the same uConn.
+//
+// If the Session already has the S11-U tunnel, it is deleted and replaced with the
+// new one. The tunnel is kept until DeleteS11UTunnel is called or the Session is
+// removed from Conn.
+func (c *Conn) CreateS11UTunnel(s *Session, uConn *gtpv1.UPlaneConn, v4, v6 string) (*ie.IE, error) {
+	if err := c.DeleteS11UTunnel(s); err != nil {
+		return nil, err
+	}
+
+	fteid := uConn.NewFTEID(IFTypeS11MMEGTPU, v4, v6)
+	if fteid == nil {
+		return nil, &RequiredParameterMissingError{"TEID", "failed to allocate TEID for S11-U"}
+	}
+	teid := fteid.MustTEID()
+
+	t := &s11uTunnel{
+		uConn:  uConn,
+		teidIn: teid,
+		rxCh:   make(chan []byte, 100),
+	}
+
+	s.mu.Lock()
+	s.s11u = t
+	s.mu.Unlock()
+	c.s11uTunnels.Store(s11uKey{uConn, teid}, t)
+
+	s.AddTEID(IFTypeS11MMEGTPU, teid)
+	return fteid, nil
+}
+
+// SetS11UPeer sets the S11-U SGW F-TEID given as the destination of the user data
+// sent by WriteS11UData.
+//
+// fteid is typically the one in the Bearer Context in Create Session Response.
+func (s *Session) SetS11UPeer(fteid *ie.IE) error {
+	ifType, err := fteid.InterfaceType()
+	if err != nil {
+		return err
+	}
+	if ifType != IFTypeS11SGWGTPU {
+		return &UnexpectedIEError{IEType: fteid.Type}
+	}
+
+	teid, err := fteid.TEID()
+	if err != nil {
+		return err
+	}
+
+	var ip net.IP
+	if fteid.HasIPv4() {
+		ip, err = fteid.IPv4()
+	} else {
+		ip, err = fteid.IPv6()
+	}
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.s11u == nil {
+		s.mu.Unlock()
+		return &RequiredParameterMissingError{"S11-U tunnel", "CreateS11UTunnel should be called first"}
+	}
+	s.s11u.teidOut = teid
+	s.s11u.raddr = &net.UDPAddr{IP: ip, Port: 2152}
+	s.mu.Unlock()
+
+	s.AddTEID(IFTypeS11SGWGTPU, teid)
+	return nil
+}
+
+// WriteS11UData sends the user data given as T-PDU to the SGW over S11-U.
+func (s *Session) WriteS11UData(data []byte) (int, error) {
+	s.mu.Lock()
+	t := s.s11u
+	s.mu.Unlock()
+
+	if t == nil || t.raddr == nil {
+		return 0, &RequiredParameterMissingError{"S11-U tunnel", "S11-U tunnel is not established"}
+	}
+	return t.uConn.WriteToGTP(t.teidOut, data, t.raddr)
+}
+
+// ReadS11UData reads the user data received from the SGW over S11-U, copying it into p.
+//
+// It waits for certain period of time specified by timeout, and returns ErrTimeout
+// if nothing has arrived.
+func (s *Session) ReadS11UData(p []byte, timeout time.Duration) (int, error) {
+	s.mu.Lock()
+	t := s.s11u
+	s.mu.Unlock()
+
+	if t == nil {
+		return 0, &RequiredParameterMissingError{"S11-U tunnel", "S11-U tunnel is not established"}
+	}
+
+	select {
+	case data := <-t.rxCh:
+		return copy(p, data), nil
+	case <-time.After(timeout):
+		return 0, ErrTimeout
+	}
+}
+
+// DeleteS11UTunnel releases the S11-U tunnel of the Session.
+//
+// This is called by RemoveSession, so it is not necessary to call it explicitly when
+// removing the Session.
+func (c *Conn) DeleteS11UTunnel(s *Session) error {
+	s.mu.Lock()
+	t := s.s11u
+	s.s11u = nil
+	s.mu.Unlock()
+
+	if t == nil {
+		return nil
+	}
+	c.s11uTunnels.Delete(s11uKey{t.uConn, t.teidIn})
+
+	// CloseRelay releases the TEID even if it is not relayed.
+	return t.uConn.CloseRelay(t.teidIn)
+}
+
+// EnableS11U makes uConn pass the T-PDUs received to the Sessions that own the
+// S11-U tunnel with the TEID, which can be read with (*Session).ReadS11UData.
+//
+// This overrides the T-PDU handler of uConn. The T-PDUs with unknown TEID are
+// responded with Error Indication.
+func (c *Conn) EnableS11U(uConn *gtpv1.UPlaneConn) {
+	uConn.AddHandler(v1msg.MsgTypeTPDU, func(_ gtpv1.Conn, senderAddr net.Addr, msg v1msg.Message) error {
+		pdu, ok := msg.(*v1msg.TPDU)
+		if !ok {
+			return gtpv1.ErrUnexpectedType
+		}
+
+		t := c.lookupS11UTunnel(uConn, pdu.TEID())
+		if t == nil {
+			return uConn.ErrorIndication(senderAddr, pdu)
+		}
+
+		select {
+		case t.rxCh <- pdu.Payload:
+		default:
+			logf("S11-U queue for TEID %#08x is full, discarding T-PDU", pdu.TEID())
+		}
+		return nil
+	})
+}
+
+// lookupS11UTunnel looks up the S11-U tunnel on uConn with the TEID given.
+func (c *Conn) lookupS11UTunnel(uConn *gtpv1.UPlaneConn, teid uint32) *s11uTunnel {
+	t, ok := c.s11uTunnels.Load(s11uKey{uConn, teid})
+	if !ok {
+		return nil
+	}
+	return t.(*s11uTunnel)
+}
@@ -0,0 +1,105 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2"
+)
+
+func TestS11UTunnel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mmeConn := listenUPlane(ctx, t, "127.0.0.31")
+	sgwConn := listenUPlane(ctx, t, "127.0.0.32")
+
+	conn := gtpv2.NewConn(&net.UDPAddr{IP: net.ParseIP("127.0.0.31"), Port: 2123}, gtpv2.IFTypeS11MMEGTPC, 0)
+	conn.EnableS11U(mmeConn)
+
+	sess := gtpv2.NewSession(&net.UDPAddr{IP: net.ParseIP("127.0.0.32"), Port: 2123}, &gtpv2.Subscriber{IMSI: "123451234567890"})
+	conn.RegisterSession(0x11111111, sess)
+
+	// the tunnel created first should be released on replacement.
+	oldFTEID, err := conn.CreateS11UTunnel(sess, mmeConn, "127.0.0.31", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mmeFTEID, err := conn.CreateS11UTunnel(sess, mmeConn, "127.0.0.31", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := mmeFTEID.MustInterfaceType(); got != gtpv2.IFTypeS11MMEGTPU {
+		t.Errorf("got interface type %d, want %d", got, gtpv2.IFTypeS11MMEGTPU)
+	}
+	mmeTEID := mmeFTEID.MustTEID()
+	if teid, err := sess.GetTEID(gtpv2.IFTypeS11MMEGTPU); err != nil || teid != mmeTEID {
+		t.Errorf("got TEID %#x, want %#x, err: %v", teid, mmeTEID, err)
+	}
+
+	if err := sess.SetS11UPeer(mmeFTEID); err == nil {
+		t.Error("expected error with the F-TEID of unexpected interface type, got nil")
+	}
+	sgwFTEID := sgwConn.NewFTEID(gtpv2.IFTypeS11SGWGTPU, "127.0.0.32", "")
+	if err := sess.SetS11UPeer(sgwFTEID); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Uplink", func(t *testing.T) {
+		ul := []byte{0xde, 0xad, 0xbe, 0xef}
+		if _, err := sess.WriteS11UData(ul); err != nil {
+			t.Fatal(err)
+		}
+
+		buf := make([]byte, 1500)
+		n, _, teid, err := sgwConn.ReadFromGTP(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if teid != sgwFTEID.MustTEID() {
+			t.Errorf("got TEID %#x, want %#x", teid, sgwFTEID.MustTEID())
+		}
+		if !bytes.Equal(buf[:n], ul) {
+			t.Errorf("got %x, want %x", buf[:n], ul)
+		}
+	})
+
+	t.Run("Downlink", func(t *testing.T) {
+		dl := []byte{0xca, 0xfe, 0xba, 0xbe}
+		if _, err := sgwConn.WriteToGTP(mmeTEID, dl, mmeConn.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+
+		buf := make([]byte, 1500)
+		n, err := sess.ReadS11UData(buf, 3*time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(buf[:n], dl) {
+			t.Errorf("got %x, want %x", buf[:n], dl)
+		}
+	})
+
+	t.Run("ReplacedTunnel", func(t *testing.T) {
+		if _, err := sgwConn.WriteToGTP(oldFTEID.MustTEID(), []byte{0x00}, mmeConn.LocalAddr()); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := sess.ReadS11UData(make([]byte, 1500), 200*time.Millisecond); !errors.Is(err, gtpv2.ErrTimeout) {
+			t.Errorf("T-PDU to the replaced tunnel should be discarded, got err: %v", err)
+		}
+	})
+
+	// the tunnel should be released with the Session.
+	conn.RemoveSession(sess)
+	if _, err := sess.WriteS11UData([]byte{0x00}); err == nil {
+		t.Error("expected error after removing Session, got nil")
+	}
+}
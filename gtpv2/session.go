@@ -42,6 +42,15 @@ type Session struct {
 	peerAddr       net.Addr
 	peerAddrString string
 
+	// fwdTEIDs are the TEIDs allocated for the indirect data forwarding tunnels.
+	fwdTEIDs []uint32
+
+	// s11u is the S11-U tunnel used with Control Plane CIoT EPS optimisation.
+	s11u *s11uTunnel
+
+	// locationReporting is the state of location change reporting requested by the PGW.
+	locationReporting *locationReporting
+
 	// Subscriber is a Subscriber associated with Session.
 	*Subscriber
 }
@@ -0,0 +1,322 @@
+// Copyright 2019-2022 go-gtp authors. All rights reserved.
+// Use of this source code is governed by a MIT-style license that can be
+// found in the LICENSE file.
+
+package gtpv2
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wmnsk/go-gtp/gtpv2/ie"
+	"github.com/wmnsk/go-gtp/gtpv2/message"
+)
+
+// triggeredMsgTypes is the list of message types that can be sent in response to
+// the initial message used as a key.
+//
+// TS29.274 7.6 Reliable Delivery of Signalling Messages;
+// A triggered message is sent with the same Sequence Number as the initial message,
+// and it is the only thing that stops the retransmission of the initial message.
+var triggeredMsgTypes = map[uint8][]uint8{
+	message.MsgTypeEchoRequest:                               {message.MsgTypeEchoResponse},
+	message.MsgTypeDirectTransferRequest:                     {message.MsgTypeDirectTransferResponse},
+	message.MsgTypeNotificationRequest:                       {message.MsgTypeNotificationResponse},
+	message.MsgTypeSRVCCPsToCsRequest:                        {message.MsgTypeSRVCCPsToCsResponse},
+	message.MsgTypeSRVCCPsToCsCompleteNotification:           {message.MsgTypeSRVCCPsToCsCompleteAcknowledge},
+	message.MsgTypeSRVCCPsToCsCancelNotification:             {message.MsgTypeSRVCCPsToCsCancelAcknowledge},
+	message.MsgTypeSRVCCCsToPsRequest:                        {message.MsgTypeSRVCCCsToPsResponse},
+	message.MsgTypeSRVCCCsToPsCompleteNotification:           {message.MsgTypeSRVCCCsToPsCompleteAcknowledge},
+	message.MsgTypeSRVCCCsToPsCancelNotification:             {message.MsgTypeSRVCCCsToPsCancelAcknowledge},
+	message.MsgTypeCreateSessionRequest:                      {message.MsgTypeCreateSessionResponse},
+	message.MsgTypeModifyBearerRequest:                       {message.MsgTypeModifyBearerResponse},
+	message.MsgTypeDeleteSessionRequest:                      {message.MsgTypeDeleteSessionResponse},
+	message.MsgTypeChangeNotificationRequest:                 {message.MsgTypeChangeNotificationResponse},
+	message.MsgTypeRemoteUEReportNotification:                {message.MsgTypeRemoteUEReportAcknowledge},
+	message.MsgTypeCreateBearerRequest:                       {message.MsgTypeCreateBearerResponse},
+	message.MsgTypeUpdateBearerRequest:                       {message.MsgTypeUpdateBearerResponse},
+	message.MsgTypeDeleteBearerRequest:                       {message.MsgTypeDeleteBearerResponse},
+	message.MsgTypeDeletePDNConnectionSetRequest:             {message.MsgTypeDeletePDNConnectionSetResponse},
+	message.MsgTypePGWDownlinkTriggeringNotification:         {message.MsgTypePGWDownlinkTriggeringAcknowledge},
+	message.MsgTypeIdentificationRequest:                     {message.MsgTypeIdentificationResponse},
+	message.MsgTypeContextRequest:                            {message.MsgTypeContextResponse},
+	message.MsgTypeForwardRelocationRequest:                  {message.MsgTypeForwardRelocationResponse},
+	message.MsgTypeForwardRelocationCompleteNotification:     {message.MsgTypeForwardRelocationCompleteAcknowledge},
+	message.MsgTypeForwardAccessContextNotification:          {message.MsgTypeForwardAccessContextAcknowledge},
+	message.MsgTypeRelocationCancelRequest:                   {message.MsgTypeRelocationCancelResponse},
+	message.MsgTypeDetachNotification:                        {message.MsgTypeDetachAcknowledge},
+	message.MsgTypeAlertMMENotification:                      {message.MsgTypeAlertMMEAcknowledge},
+	message.MsgTypeUEActivityNotification:                    {message.MsgTypeUEActivityAcknowledge},
+	message.MsgTypeUERegistrationQueryRequest:                {message.MsgTypeUERegistrationQueryResponse},
+	message.MsgTypeCreateForwardingTunnelRequest:             {message.MsgTypeCreateForwardingTunnelResponse},
+	message.MsgTypeSuspendNotification:                       {message.MsgTypeSuspendAcknowledge},
+	message.MsgTypeResumeNotification:                        {message.MsgTypeResumeAcknowledge},
+	message.MsgTypeCreateIndirectDataForwardingTunnelRequest: {message.MsgTypeCreateIndirectDataForwardingTunnelResponse},
+	message.MsgTypeDeleteIndirectDataForwardingTunnelRequest: {message.MsgTypeDeleteIndirectDataForwardingTunnelResponse},
+	message.MsgTypeReleaseAccessBearersRequest:               {message.MsgTypeReleaseAccessBearersResponse},
+	message.MsgTypeDownlinkDataNotification:                  {message.MsgTypeDownlinkDataNotificationAcknowledge},
+	message.MsgTypePGWRestartNotification:                    {message.MsgTypePGWRestartNotificationAcknowledge},
+	message.MsgTypeUpdatePDNConnectionSetRequest:             {message.MsgTypeUpdatePDNConnectionSetResponse},
+	message.MsgTypeModifyAccessBearersRequest:                {message.MsgTypeModifyAccessBearersResponse},
+	message.MsgTypeMBMSSessionStartRequest:                   {message.MsgTypeMBMSSessionStartResponse},
+	message.MsgTypeMBMSSessionUpdateRequest:                  {message.MsgTypeMBMSSessionUpdateResponse},
+	message.MsgTypeMBMSSessionStopRequest:                    {message.MsgTypeMBMSSessionStopResponse},
+
+	// Commands are answered by the Request triggered by them or by Failure Indication.
+	message.MsgTypeModifyBearerCommand: {
+		message.MsgTypeUpdateBearerRequest,
+		message.MsgTypeModifyBearerFailureIndication,
+	},
+	message.MsgTypeDeleteBearerCommand: {
+		message.MsgTypeDeleteBearerRequest,
+		message.MsgTypeDeleteBearerFailureIndication,
+	},
+	message.MsgTypeBearerResourceCommand: {
+		message.MsgTypeCreateBearerRequest,
+		message.MsgTypeUpdateBearerRequest,
+		message.MsgTypeDeleteBearerRequest,
+		message.MsgTypeBearerResourceFailureIndication,
+	},
+}
+
+// isInitialMessage reports whether the message type given is an initial message that
+// expects a triggered message in response.
+func isInitialMessage(msgType uint8) bool {
+	_, ok := triggeredMsgTypes[msgType]
+	return ok
+}
+
+// isTriggeredBy reports whether the message of type res can be the response to
+// the initial message of type req.
+func isTriggeredBy(res, req uint8) bool {
+	// Version Not Supported Indication can be sent in response to any message.
+	if res == message.MsgTypeVersionNotSupportedIndication {
+		return true
+	}
+
+	for _, t := range triggeredMsgTypes[req] {
+		if t == res {
+			return true
+		}
+	}
+	return false
+}
+
+// commandSequenceFlag is set to the Sequence Number of the Command messages to
+// distinguish the Requests triggered by them from the ones initiated by the peer.
+//
+// TS29.274 7.6 Reliable Delivery of Signalling Messages
+const commandSequenceFlag uint32 = 0x800000
+
+// isCommandMessage reports whether the message type given is a Command message.
+func isCommandMessage(msgType uint8) bool {
+	switch msgType {
+	case message.MsgTypeModifyBearerCommand,
+		message.MsgTypeDeleteBearerCommand,
+		message.MsgTypeBearerResourceCommand:
+		return true
+	default:
+		return false
+	}
+}
+
+// ptiOf returns the PTI in the message if it has one.
+func ptiOf(msg message.Message) (uint8, bool) {
+	var i *ie.IE
+	switch m := msg.(type) {
+	case *message.BearerResourceCommand:
+		i = m.PTI
+	case *message.CreateBearerRequest:
+		i = m.PTI
+	case *message.UpdateBearerRequest:
+		i = m.PTI
+	case *message.DeleteBearerRequest:
+		i = m.PTI
+	}
+	if i == nil {
+		return 0, false
+	}
+
+	pti, err := i.ProcedureTransactionID()
+	if err != nil {
+		return 0, false
+	}
+	return pti, true
+}
+
+// transaction is an initial message sent and waiting for the triggered message.
+type transaction struct {
+	mu      sync.Mutex
+	raddr   net.Addr
+	msg     message.Message
+	payload []byte
+	doneCh  chan struct{}
+	done    bool
+
+	// waiting is true if the triggered message is waited by Request and thus
+	// should not be passed to the HandlerFunc.
+	waiting bool
+	res     message.Message
+	err     error
+
+	// retransmitting is true if the transaction is handled by (*Conn).retransmit.
+	retransmitting bool
+}
+
+func newTransaction(raddr net.Addr, msg message.Message, payload []byte, waiting bool) *transaction {
+	return &transaction{
+		raddr:   raddr,
+		msg:     msg,
+		payload: payload,
+		doneCh:  make(chan struct{}),
+		waiting: waiting,
+	}
+}
+
+// matches reports whether the message received can complete the transaction.
+// The Request triggered by Bearer Resource Command should have the same PTI.
+func (t *transaction) matches(msg message.Message) bool {
+	if !isTriggeredBy(msg.MessageType(), t.msg.MessageType()) {
+		return false
+	}
+	if t.msg.MessageType() != message.MsgTypeBearerResourceCommand {
+		return true
+	}
+
+	switch msg.MessageType() {
+	case message.MsgTypeCreateBearerRequest,
+		message.MsgTypeUpdateBearerRequest,
+		message.MsgTypeDeleteBearerRequest:
+		want, _ := ptiOf(t.msg)
+		got, ok := ptiOf(msg)
+		return ok && got == want
+	default:
+		return true
+	}
+}
+
+// complete marks the transaction done with the triggered message or the error,
+// and stops the retransmission.
+// It returns false if the transaction has already been done.
+func (t *transaction) complete(res message.Message, err error) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.done {
+		return false
+	}
+	t.done = true
+	t.res = res
+	t.err = err
+	close(t.doneCh)
+	return true
+}
+
+// result returns the triggered message or the error the transaction is completed with.
+func (t *transaction) result() (message.Message, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.res, t.err
+}
+
+type transactionKey struct {
+	peer string
+	seq  uint32
+}
+
+type transactionMap struct {
+	syncMap sync.Map
+}
+
+func newTransactionMap() *transactionMap {
+	return &transactionMap{}
+}
+
+func (t *transactionMap) store(peer net.Addr, seq uint32, tx *transaction) {
+	t.syncMap.Store(transactionKey{peer.String(), seq}, tx)
+}
+
+func (t *transactionMap) load(peer net.Addr, seq uint32) (*transaction, bool) {
+	tx, ok := t.syncMap.Load(transactionKey{peer.String(), seq})
+	if !ok {
+		return nil, false
+	}
+	return tx.(*transaction), true
+}
+
+func (t *transactionMap) delete(peer net.Addr, seq uint32) {
+	t.syncMap.Delete(transactionKey{peer.String(), seq})
+}
+
+// cachedResponse is the response sent to the initial message received.
+//
+// payload is nil while the initial message is being handled, which means the
+// duplicated message should just be discarded.
+type cachedResponse struct {
+	mu      sync.Mutex
+	payload []byte
+	timer   *time.Timer
+}
+
+type requestKey struct {
+	peer    string
+	seq     uint32
+	msgType uint8
+}
+
+// requestCache keeps the responses to the initial messages received, to be
+// retransmitted on receiving the duplicated initial message.
+type requestCache struct {
+	syncMap sync.Map
+}
+
+func newRequestCache() *requestCache {
+	return &requestCache{}
+}
+
+// tryStore marks the initial message as received, and returns false with the
+// entry already stored if the message is a duplicate.
+func (r *requestCache) tryStore(peer net.Addr, msg message.Message, lifetime time.Duration) (*cachedResponse, bool) {
+	key := requestKey{peer.String(), msg.Sequence(), msg.MessageType()}
+	res := &cachedResponse{}
+
+	v, loaded := r.syncMap.LoadOrStore(key, res)
+	if loaded {
+		return v.(*cachedResponse), false
+	}
+
+	res.mu.Lock()
+	res.timer = time.AfterFunc(lifetime, func() {
+		r.syncMap.Delete(key)
+	})
+	res.mu.Unlock()
+	return res, true
+}
+
+// setResponse stores the response to the initial message received, and extends
+// the lifetime of the entry so that the retransmitted message can be answered.
+func (r *requestCache) setResponse(peer net.Addr, req message.Message, payload []byte, lifetime time.Duration) {
+	v, ok := r.syncMap.Load(requestKey{peer.String(), req.Sequence(), req.MessageType()})
+	if !ok {
+		return
+	}
+
+	res := v.(*cachedResponse)
+	res.mu.Lock()
+	defer res.mu.Unlock()
+
+	res.payload = make([]byte, len(payload))
+	copy(res.payload, payload)
+	if res.timer != nil {
+		res.timer.Reset(lifetime)
+	}
+}
+
+func (r *cachedResponse) response() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.payload
+}